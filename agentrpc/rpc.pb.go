@@ -20,6 +20,343 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MatchMode specifies how func_name is matched against the functions of the
+// stack frames.
+type FrameSpec_MatchMode int32
+
+const (
+	// SUFFIX matches functions whose fully-qualified name ends with func_name
+	// preceded by '.', ')' or '/' (e.g. "execStmt" matches both
+	// "pkg/sql.(*connExecutor).execStmt" and "pkg/foo.execStmt", but not
+	// "pkg/foo.reexecStmt").
+	FrameSpec_SUFFIX FrameSpec_MatchMode = 0
+	// EXACT matches the function whose fully-qualified name is func_name (e.g.
+	// "github.com/cockroachdb/cockroach/pkg/sql.(*connExecutor).execStmt").
+	FrameSpec_EXACT FrameSpec_MatchMode = 1
+	// REGEX matches functions whose fully-qualified name matches the regular
+	// expression func_name. The regular expression is not anchored.
+	FrameSpec_REGEX FrameSpec_MatchMode = 2
+	// PACKAGE_PREFIX matches all the functions in the package func_name and
+	// in the packages under it. For example, "net" matches "net" and
+	// "net/http", but not "netip".
+	FrameSpec_PACKAGE_PREFIX FrameSpec_MatchMode = 3
+)
+
+// Enum value maps for FrameSpec_MatchMode.
+var (
+	FrameSpec_MatchMode_name = map[int32]string{
+		0: "SUFFIX",
+		1: "EXACT",
+		2: "REGEX",
+		3: "PACKAGE_PREFIX",
+	}
+	FrameSpec_MatchMode_value = map[string]int32{
+		"SUFFIX":         0,
+		"EXACT":          1,
+		"REGEX":          2,
+		"PACKAGE_PREFIX": 3,
+	}
+)
+
+func (x FrameSpec_MatchMode) Enum() *FrameSpec_MatchMode {
+	p := new(FrameSpec_MatchMode)
+	*p = x
+	return p
+}
+
+func (x FrameSpec_MatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrameSpec_MatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[0].Descriptor()
+}
+
+func (FrameSpec_MatchMode) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[0]
+}
+
+func (x FrameSpec_MatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrameSpec_MatchMode.Descriptor instead.
+func (FrameSpec_MatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{12, 0}
+}
+
+// Aggregation controls how goroutines are aggregated into the profile's
+// samples.
+type GetSnapshotIn_Aggregation int32
+
+const (
+	// SIMILAR_ANY_VALUE aggregates goroutines whose stacks have the same
+	// functions, lines and number of arguments, regardless of the arguments'
+	// values (panicparse's AnyValue similarity).
+	GetSnapshotIn_SIMILAR_ANY_VALUE GetSnapshotIn_Aggregation = 0
+	// SIMILAR_ANY_POINTER aggregates goroutines whose stacks are identical,
+	// except for the values of arguments that look like pointers
+	// (panicparse's AnyPointer similarity).
+	GetSnapshotIn_SIMILAR_ANY_POINTER GetSnapshotIn_Aggregation = 1
+	// EXACT aggregates goroutines only if their stacks are identical,
+	// including the arguments (panicparse's ExactFlags similarity).
+	GetSnapshotIn_EXACT GetSnapshotIn_Aggregation = 2
+	// NONE produces one sample per goroutine.
+	GetSnapshotIn_NONE GetSnapshotIn_Aggregation = 3
+)
+
+// Enum value maps for GetSnapshotIn_Aggregation.
+var (
+	GetSnapshotIn_Aggregation_name = map[int32]string{
+		0: "SIMILAR_ANY_VALUE",
+		1: "SIMILAR_ANY_POINTER",
+		2: "EXACT",
+		3: "NONE",
+	}
+	GetSnapshotIn_Aggregation_value = map[string]int32{
+		"SIMILAR_ANY_VALUE":   0,
+		"SIMILAR_ANY_POINTER": 1,
+		"EXACT":               2,
+		"NONE":                3,
+	}
+)
+
+func (x GetSnapshotIn_Aggregation) Enum() *GetSnapshotIn_Aggregation {
+	p := new(GetSnapshotIn_Aggregation)
+	*p = x
+	return p
+}
+
+func (x GetSnapshotIn_Aggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetSnapshotIn_Aggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[1].Descriptor()
+}
+
+func (GetSnapshotIn_Aggregation) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[1]
+}
+
+func (x GetSnapshotIn_Aggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetSnapshotIn_Aggregation.Descriptor instead.
+func (GetSnapshotIn_Aggregation) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type FindGoroutinesIn_MatchMode int32
+
+const (
+	FindGoroutinesIn_EXACT     FindGoroutinesIn_MatchMode = 0
+	FindGoroutinesIn_SUBSTRING FindGoroutinesIn_MatchMode = 1
+	FindGoroutinesIn_REGEX     FindGoroutinesIn_MatchMode = 2
+)
+
+// Enum value maps for FindGoroutinesIn_MatchMode.
+var (
+	FindGoroutinesIn_MatchMode_name = map[int32]string{
+		0: "EXACT",
+		1: "SUBSTRING",
+		2: "REGEX",
+	}
+	FindGoroutinesIn_MatchMode_value = map[string]int32{
+		"EXACT":     0,
+		"SUBSTRING": 1,
+		"REGEX":     2,
+	}
+)
+
+func (x FindGoroutinesIn_MatchMode) Enum() *FindGoroutinesIn_MatchMode {
+	p := new(FindGoroutinesIn_MatchMode)
+	*p = x
+	return p
+}
+
+func (x FindGoroutinesIn_MatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FindGoroutinesIn_MatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[2].Descriptor()
+}
+
+func (FindGoroutinesIn_MatchMode) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[2]
+}
+
+func (x FindGoroutinesIn_MatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FindGoroutinesIn_MatchMode.Descriptor instead.
+func (FindGoroutinesIn_MatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{30, 0}
+}
+
+type ChannelWait_Operation int32
+
+const (
+	ChannelWait_RECEIVE ChannelWait_Operation = 0
+	ChannelWait_SEND    ChannelWait_Operation = 1
+	ChannelWait_SELECT  ChannelWait_Operation = 2
+)
+
+// Enum value maps for ChannelWait_Operation.
+var (
+	ChannelWait_Operation_name = map[int32]string{
+		0: "RECEIVE",
+		1: "SEND",
+		2: "SELECT",
+	}
+	ChannelWait_Operation_value = map[string]int32{
+		"RECEIVE": 0,
+		"SEND":    1,
+		"SELECT":  2,
+	}
+)
+
+func (x ChannelWait_Operation) Enum() *ChannelWait_Operation {
+	p := new(ChannelWait_Operation)
+	*p = x
+	return p
+}
+
+func (x ChannelWait_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelWait_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[3].Descriptor()
+}
+
+func (ChannelWait_Operation) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[3]
+}
+
+func (x ChannelWait_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelWait_Operation.Descriptor instead.
+func (ChannelWait_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type SyncPrimitive_Kind int32
+
+const (
+	SyncPrimitive_WAIT_GROUP SyncPrimitive_Kind = 0
+	SyncPrimitive_COND       SyncPrimitive_Kind = 1
+	SyncPrimitive_RW_MUTEX   SyncPrimitive_Kind = 2
+	// SEMAPHORE is a runtime semaphore (semacquire) that is not part of one of
+	// the other primitives or of a mutex.
+	SyncPrimitive_SEMAPHORE SyncPrimitive_Kind = 3
+)
+
+// Enum value maps for SyncPrimitive_Kind.
+var (
+	SyncPrimitive_Kind_name = map[int32]string{
+		0: "WAIT_GROUP",
+		1: "COND",
+		2: "RW_MUTEX",
+		3: "SEMAPHORE",
+	}
+	SyncPrimitive_Kind_value = map[string]int32{
+		"WAIT_GROUP": 0,
+		"COND":       1,
+		"RW_MUTEX":   2,
+		"SEMAPHORE":  3,
+	}
+)
+
+func (x SyncPrimitive_Kind) Enum() *SyncPrimitive_Kind {
+	p := new(SyncPrimitive_Kind)
+	*p = x
+	return p
+}
+
+func (x SyncPrimitive_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncPrimitive_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[4].Descriptor()
+}
+
+func (SyncPrimitive_Kind) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[4]
+}
+
+func (x SyncPrimitive_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncPrimitive_Kind.Descriptor instead.
+func (SyncPrimitive_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{50, 0}
+}
+
+type ScriptParam_Type int32
+
+const (
+	ScriptParam_STRING ScriptParam_Type = 0
+	ScriptParam_INT    ScriptParam_Type = 1
+	ScriptParam_FLOAT  ScriptParam_Type = 2
+	ScriptParam_BOOL   ScriptParam_Type = 3
+	// JSON parameters take arbitrary JSON values, which the script sees
+	// decoded.
+	ScriptParam_JSON ScriptParam_Type = 4
+)
+
+// Enum value maps for ScriptParam_Type.
+var (
+	ScriptParam_Type_name = map[int32]string{
+		0: "STRING",
+		1: "INT",
+		2: "FLOAT",
+		3: "BOOL",
+		4: "JSON",
+	}
+	ScriptParam_Type_value = map[string]int32{
+		"STRING": 0,
+		"INT":    1,
+		"FLOAT":  2,
+		"BOOL":   3,
+		"JSON":   4,
+	}
+)
+
+func (x ScriptParam_Type) Enum() *ScriptParam_Type {
+	p := new(ScriptParam_Type)
+	*p = x
+	return p
+}
+
+func (x ScriptParam_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScriptParam_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[5].Descriptor()
+}
+
+func (ScriptParam_Type) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[5]
+}
+
+func (x ScriptParam_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScriptParam_Type.Descriptor instead.
+func (ScriptParam_Type) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{66, 0}
+}
+
 type GetTypeInfoIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -726,19 +1063,139 @@ func (x *ListTypesOut) GetTypes() []string {
 	return nil
 }
 
+// LoadConfig controls how much of a variable's value is read from the target.
+// It mirrors Delve's api.LoadConfig. Fields that are not set take the agent's
+// defaults. The agent caps values exceeding its upper bounds, so that a request
+// cannot blow up the size of the response or the duration for which the target
+// is paused.
+type LoadConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// follow_pointers requests pointers to be automatically dereferenced.
+	FollowPointers *bool `protobuf:"varint,1,opt,name=follow_pointers,json=followPointers,proto3,oneof" json:"follow_pointers,omitempty"`
+	// follow_interfaces requests interfaces to be automatically resolved to
+	// their concrete values.
+	FollowInterfaces *bool `protobuf:"varint,2,opt,name=follow_interfaces,json=followInterfaces,proto3,oneof" json:"follow_interfaces,omitempty"`
+	// max_variable_recurse is how far to recurse when loading nested types.
+	MaxVariableRecurse *int32 `protobuf:"varint,3,opt,name=max_variable_recurse,json=maxVariableRecurse,proto3,oneof" json:"max_variable_recurse,omitempty"`
+	// max_string_len is the maximum number of bytes read from a string.
+	MaxStringLen *int32 `protobuf:"varint,4,opt,name=max_string_len,json=maxStringLen,proto3,oneof" json:"max_string_len,omitempty"`
+	// max_array_values is the maximum number of elements read from an array, a
+	// slice or a map.
+	MaxArrayValues *int32 `protobuf:"varint,5,opt,name=max_array_values,json=maxArrayValues,proto3,oneof" json:"max_array_values,omitempty"`
+	// max_struct_fields is the maximum number of fields read from a struct. -1
+	// means all the fields (subject to the agent's upper bound).
+	MaxStructFields *int32 `protobuf:"varint,6,opt,name=max_struct_fields,json=maxStructFields,proto3,oneof" json:"max_struct_fields,omitempty"`
+}
+
+func (x *LoadConfig) Reset() {
+	*x = LoadConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadConfig) ProtoMessage() {}
+
+func (x *LoadConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadConfig.ProtoReflect.Descriptor instead.
+func (*LoadConfig) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LoadConfig) GetFollowPointers() bool {
+	if x != nil && x.FollowPointers != nil {
+		return *x.FollowPointers
+	}
+	return false
+}
+
+func (x *LoadConfig) GetFollowInterfaces() bool {
+	if x != nil && x.FollowInterfaces != nil {
+		return *x.FollowInterfaces
+	}
+	return false
+}
+
+func (x *LoadConfig) GetMaxVariableRecurse() int32 {
+	if x != nil && x.MaxVariableRecurse != nil {
+		return *x.MaxVariableRecurse
+	}
+	return 0
+}
+
+func (x *LoadConfig) GetMaxStringLen() int32 {
+	if x != nil && x.MaxStringLen != nil {
+		return *x.MaxStringLen
+	}
+	return 0
+}
+
+func (x *LoadConfig) GetMaxArrayValues() int32 {
+	if x != nil && x.MaxArrayValues != nil {
+		return *x.MaxArrayValues
+	}
+	return 0
+}
+
+func (x *LoadConfig) GetMaxStructFields() int32 {
+	if x != nil && x.MaxStructFields != nil {
+		return *x.MaxStructFields
+	}
+	return 0
+}
+
 type FrameSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FuncName    string   `protobuf:"bytes,1,opt,name=func_name,json=funcName,proto3" json:"func_name,omitempty"`
+	FuncName string `protobuf:"bytes,1,opt,name=func_name,json=funcName,proto3" json:"func_name,omitempty"`
+	// expressions are evaluated in the scope of the frames matching the spec.
 	Expressions []string `protobuf:"bytes,2,rep,name=expressions,proto3" json:"expressions,omitempty"`
+	// load_config, if set, overrides the agent's default load configuration for
+	// all the expressions.
+	LoadConfig *LoadConfig `protobuf:"bytes,3,opt,name=load_config,json=loadConfig,proto3" json:"load_config,omitempty"`
+	// expression_load_configs overrides the load configuration for individual
+	// expressions, keyed by expression. The fields set here take precedence over
+	// load_config.
+	ExpressionLoadConfigs map[string]*LoadConfig `protobuf:"bytes,4,rep,name=expression_load_configs,json=expressionLoadConfigs,proto3" json:"expression_load_configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MatchMode             FrameSpec_MatchMode    `protobuf:"varint,5,opt,name=match_mode,json=matchMode,proto3,enum=agentrpc.FrameSpec_MatchMode" json:"match_mode,omitempty"`
+	// line_range, if set, restricts the matching to frames currently stopped
+	// within the range. The expressions are not evaluated for frames stopped
+	// outside of it.
+	LineRange *FrameSpec_LineRange `protobuf:"bytes,6,opt,name=line_range,json=lineRange,proto3" json:"line_range,omitempty"`
+	// pc_offset_range, if set, restricts the matching to frames currently
+	// stopped within the range.
+	PcOffsetRange *FrameSpec_PCOffsetRange `protobuf:"bytes,7,opt,name=pc_offset_range,json=pcOffsetRange,proto3" json:"pc_offset_range,omitempty"`
+	// relative_expressions are evaluated in the scope of frames identified
+	// relative to the frames matching the spec.
+	RelativeExpressions []*FrameSpec_RelativeExpression `protobuf:"bytes,8,rep,name=relative_expressions,json=relativeExpressions,proto3" json:"relative_expressions,omitempty"`
 }
 
 func (x *FrameSpec) Reset() {
 	*x = FrameSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[11]
+		mi := &file_rpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -751,7 +1208,7 @@ func (x *FrameSpec) String() string {
 func (*FrameSpec) ProtoMessage() {}
 
 func (x *FrameSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[11]
+	mi := &file_rpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +1221,7 @@ func (x *FrameSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrameSpec.ProtoReflect.Descriptor instead.
 func (*FrameSpec) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{11}
+	return file_rpc_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *FrameSpec) GetFuncName() string {
@@ -781,6 +1238,48 @@ func (x *FrameSpec) GetExpressions() []string {
 	return nil
 }
 
+func (x *FrameSpec) GetLoadConfig() *LoadConfig {
+	if x != nil {
+		return x.LoadConfig
+	}
+	return nil
+}
+
+func (x *FrameSpec) GetExpressionLoadConfigs() map[string]*LoadConfig {
+	if x != nil {
+		return x.ExpressionLoadConfigs
+	}
+	return nil
+}
+
+func (x *FrameSpec) GetMatchMode() FrameSpec_MatchMode {
+	if x != nil {
+		return x.MatchMode
+	}
+	return FrameSpec_SUFFIX
+}
+
+func (x *FrameSpec) GetLineRange() *FrameSpec_LineRange {
+	if x != nil {
+		return x.LineRange
+	}
+	return nil
+}
+
+func (x *FrameSpec) GetPcOffsetRange() *FrameSpec_PCOffsetRange {
+	if x != nil {
+		return x.PcOffsetRange
+	}
+	return nil
+}
+
+func (x *FrameSpec) GetRelativeExpressions() []*FrameSpec_RelativeExpression {
+	if x != nil {
+		return x.RelativeExpressions
+	}
+	return nil
+}
+
 type TypeSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -789,12 +1288,16 @@ type TypeSpec struct {
 	TypeName    string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
 	CollectAll  bool     `protobuf:"varint,2,opt,name=collect_all,json=collectAll,proto3" json:"collect_all,omitempty"`
 	Expressions []string `protobuf:"bytes,3,rep,name=expressions,proto3" json:"expressions,omitempty"`
+	// load_config, if set, controls how values of this type are loaded wherever
+	// they are encountered while loading the value of an expression. It takes
+	// precedence over the load configuration of the expression.
+	LoadConfig *LoadConfig `protobuf:"bytes,4,opt,name=load_config,json=loadConfig,proto3" json:"load_config,omitempty"`
 }
 
 func (x *TypeSpec) Reset() {
 	*x = TypeSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[12]
+		mi := &file_rpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -807,7 +1310,7 @@ func (x *TypeSpec) String() string {
 func (*TypeSpec) ProtoMessage() {}
 
 func (x *TypeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[12]
+	mi := &file_rpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +1323,7 @@ func (x *TypeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeSpec.ProtoReflect.Descriptor instead.
 func (*TypeSpec) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{12}
+	return file_rpc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TypeSpec) GetTypeName() string {
@@ -844,6 +1347,13 @@ func (x *TypeSpec) GetExpressions() []string {
 	return nil
 }
 
+func (x *TypeSpec) GetLoadConfig() *LoadConfig {
+	if x != nil {
+		return x.LoadConfig
+	}
+	return nil
+}
+
 type GetSnapshotIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -857,12 +1367,37 @@ type GetSnapshotIn struct {
 	// TypeSpecs contains specific instructions about what to collect when one of
 	// these types is encountered.
 	TypeSpecs []*TypeSpec `protobuf:"bytes,3,rep,name=type_specs,json=typeSpecs,proto3" json:"type_specs,omitempty"`
+	// max_stack_depth is the maximum number of frames collected for each
+	// goroutine. Goroutines with deeper stacks have their stacks truncated; the
+	// frames closest to the root are dropped and replaced by a synthetic
+	// "<truncated N frames>" root frame. If 0, the agent's default is used. The
+	// agent caps this to an upper bound.
+	MaxStackDepth int32 `protobuf:"varint,4,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+	// context_extractors describes values to extract from the stacks of all the
+	// goroutines.
+	ContextExtractors []*ContextExtractor `protobuf:"bytes,5,rep,name=context_extractors,json=contextExtractors,proto3" json:"context_extractors,omitempty"`
+	// group_by lists expressions of the frame specs, names of context
+	// extractors, or keys of the goroutines' pprof labels, by whose values the
+	// goroutines are grouped. The values are also attached to the profile's
+	// samples as labels keyed by the expression (or extractor name). For each
+	// goroutine, the value captured in the frame closest to the leaf is used.
+	GroupBy []string `protobuf:"bytes,6,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	// label_expressions lists expressions of the frame specs whose captured
+	// values are attached to the profile's samples as string labels keyed by the
+	// expression, like for group_by, but without grouping the goroutines in the
+	// response. Goroutines with identical stacks but different values get
+	// different samples, so the profile can be sliced with pprof's -tagfocus and
+	// -tagshow. Long values are truncated.
+	LabelExpressions []string `protobuf:"bytes,7,rep,name=label_expressions,json=labelExpressions,proto3" json:"label_expressions,omitempty"`
+	// aggregation controls how goroutines are aggregated into samples. Samples
+	// are further split by labels, regardless of the mode.
+	Aggregation GetSnapshotIn_Aggregation `protobuf:"varint,8,opt,name=aggregation,proto3,enum=agentrpc.GetSnapshotIn_Aggregation" json:"aggregation,omitempty"`
 }
 
 func (x *GetSnapshotIn) Reset() {
 	*x = GetSnapshotIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[13]
+		mi := &file_rpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -875,7 +1410,7 @@ func (x *GetSnapshotIn) String() string {
 func (*GetSnapshotIn) ProtoMessage() {}
 
 func (x *GetSnapshotIn) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[13]
+	mi := &file_rpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +1423,7 @@ func (x *GetSnapshotIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSnapshotIn.ProtoReflect.Descriptor instead.
 func (*GetSnapshotIn) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{13}
+	return file_rpc_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetSnapshotIn) GetBinaryId() int64 {
@@ -912,75 +1447,76 @@ func (x *GetSnapshotIn) GetTypeSpecs() []*TypeSpec {
 	return nil
 }
 
-type CapturedExpression struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetSnapshotIn) GetMaxStackDepth() int32 {
+	if x != nil {
+		return x.MaxStackDepth
+	}
+	return 0
+}
 
-	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
-	Value      string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (x *GetSnapshotIn) GetContextExtractors() []*ContextExtractor {
+	if x != nil {
+		return x.ContextExtractors
+	}
+	return nil
 }
 
-func (x *CapturedExpression) Reset() {
-	*x = CapturedExpression{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetSnapshotIn) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
 	}
+	return nil
 }
 
-func (x *CapturedExpression) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetSnapshotIn) GetLabelExpressions() []string {
+	if x != nil {
+		return x.LabelExpressions
+	}
+	return nil
 }
 
-func (*CapturedExpression) ProtoMessage() {}
-
-func (x *CapturedExpression) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CapturedExpression.ProtoReflect.Descriptor instead.
-func (*CapturedExpression) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *CapturedExpression) GetExpression() string {
-	if x != nil {
-		return x.Expression
-	}
-	return ""
-}
-
-func (x *CapturedExpression) GetValue() string {
+func (x *GetSnapshotIn) GetAggregation() GetSnapshotIn_Aggregation {
 	if x != nil {
-		return x.Value
+		return x.Aggregation
 	}
-	return ""
+	return GetSnapshotIn_SIMILAR_ANY_VALUE
 }
 
-// FrameData represents the data captured for a single stack frame.
-type FrameData struct {
+// ContextExtractor describes a value to extract from every goroutine whose
+// stack has a frame with a given argument; for example, the trace ID reachable
+// from a `ctx context.Context` argument. The extracted values are returned as
+// FrameData, and are attached to the profile's samples as labels.
+type ContextExtractor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GoroutineId int64 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
-	// The index of the frame in the stack. 0 is the leaf function.
-	FrameIdx      int64                 `protobuf:"varint,2,opt,name=frame_idx,json=frameIdx,proto3" json:"frame_idx,omitempty"`
-	CapturedExprs []*CapturedExpression `protobuf:"bytes,3,rep,name=captured_exprs,json=capturedExprs,proto3" json:"captured_exprs,omitempty"`
-}
-
-func (x *FrameData) Reset() {
-	*x = FrameData{}
+	// name identifies the extracted value. It is used as the key of the pprof
+	// label and as the expression of the CapturedExpression in FrameData.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// arg_name is the name of the argument that a frame needs to have for the
+	// extractor to apply to it, e.g. "ctx".
+	ArgName string `protobuf:"bytes,2,opt,name=arg_name,json=argName,proto3" json:"arg_name,omitempty"`
+	// arg_type, if set, is the type that the argument needs to have, e.g.
+	// "context.Context".
+	ArgType string `protobuf:"bytes,3,opt,name=arg_type,json=argType,proto3" json:"arg_type,omitempty"`
+	// expression is evaluated in the scope of the frames that the extractor
+	// applies to in order to produce the value. If empty, the argument itself is
+	// the value.
+	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	// all_frames, if set, makes the extractor apply to every frame that has the
+	// argument. By default, the extractor applies only to the frame closest to
+	// the leaf that has the argument. Either way, the pprof label of a goroutine
+	// uses the value extracted from the frame closest to the leaf, among the
+	// frames where the evaluation succeeded.
+	AllFrames bool `protobuf:"varint,5,opt,name=all_frames,json=allFrames,proto3" json:"all_frames,omitempty"`
+	// load_config controls how much of the value is read. Fields that are not set
+	// take the agent's defaults.
+	LoadConfig *LoadConfig `protobuf:"bytes,6,opt,name=load_config,json=loadConfig,proto3" json:"load_config,omitempty"`
+}
+
+func (x *ContextExtractor) Reset() {
+	*x = ContextExtractor{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -988,13 +1524,13 @@ func (x *FrameData) Reset() {
 	}
 }
 
-func (x *FrameData) String() string {
+func (x *ContextExtractor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrameData) ProtoMessage() {}
+func (*ContextExtractor) ProtoMessage() {}
 
-func (x *FrameData) ProtoReflect() protoreflect.Message {
+func (x *ContextExtractor) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1006,46 +1542,100 @@ func (x *FrameData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrameData.ProtoReflect.Descriptor instead.
-func (*FrameData) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContextExtractor.ProtoReflect.Descriptor instead.
+func (*ContextExtractor) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *FrameData) GetGoroutineId() int64 {
+func (x *ContextExtractor) GetName() string {
 	if x != nil {
-		return x.GoroutineId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *FrameData) GetFrameIdx() int64 {
+func (x *ContextExtractor) GetArgName() string {
 	if x != nil {
-		return x.FrameIdx
+		return x.ArgName
 	}
-	return 0
+	return ""
 }
 
-func (x *FrameData) GetCapturedExprs() []*CapturedExpression {
+func (x *ContextExtractor) GetArgType() string {
 	if x != nil {
-		return x.CapturedExprs
+		return x.ArgType
+	}
+	return ""
+}
+
+func (x *ContextExtractor) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *ContextExtractor) GetAllFrames() bool {
+	if x != nil {
+		return x.AllFrames
+	}
+	return false
+}
+
+func (x *ContextExtractor) GetLoadConfig() *LoadConfig {
+	if x != nil {
+		return x.LoadConfig
 	}
 	return nil
 }
 
-type GetSnapshotOut struct {
+// Value is a structured representation of a variable read from the target
+// process. It mirrors Delve's api.Variable.
+type Value struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
-	// // Map from goroutine ID to map from frame index to array of captured values.
-	// // The frame indexes match the order in Stacks - from leaf function to
-	// // callers.
-	FrameData []*FrameData `protobuf:"bytes,2,rep,name=frame_data,json=frameData,proto3" json:"frame_data,omitempty"`
-}
-
-func (x *GetSnapshotOut) Reset() {
-	*x = GetSnapshotOut{}
+	// name is the name of the variable, struct field or collection element. For
+	// slice and array elements, it is the index (e.g. "[3]"). For map entries,
+	// it is the single-line rendering of the key.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// kind is the Go kind of the value, as rendered by reflect.Kind (e.g.
+	// "struct", "ptr", "slice", "string", "int64").
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// type is the name of the value's type.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// value is the rendering of scalar values (numbers, bools, strings, etc.).
+	// It is empty for composite values; see children.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// address is the address of the value in the target's memory.
+	Address uint64 `protobuf:"varint,5,opt,name=address,proto3" json:"address,omitempty"`
+	// pointer_address is set for pointers to the address they point to. It is 0
+	// for nil pointers.
+	PointerAddress uint64 `protobuf:"varint,6,opt,name=pointer_address,json=pointerAddress,proto3" json:"pointer_address,omitempty"`
+	// len is the length of strings, slices, arrays, maps and channels. For
+	// structs, it is the number of fields.
+	Len int64 `protobuf:"varint,7,opt,name=len,proto3" json:"len,omitempty"`
+	// cap is the capacity of slices and channels.
+	Cap int64 `protobuf:"varint,8,opt,name=cap,proto3" json:"cap,omitempty"`
+	// children are the fields of structs, the elements of collections, the
+	// pointee of pointers, or the concrete value of interfaces. Only the
+	// children that were loaded according to the load limits are present; see
+	// truncated.
+	Children []*Value `protobuf:"bytes,9,rep,name=children,proto3" json:"children,omitempty"`
+	// truncated is set if the value was only partially loaded because of load
+	// limits: a string longer than the max string length, a collection with
+	// more elements than the max array values, or a value beyond the max
+	// recursion depth.
+	Truncated bool `protobuf:"varint,10,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// unreadable is set if the value could not be read from the target.
+	// unreadable_reason contains the error.
+	Unreadable       bool   `protobuf:"varint,11,opt,name=unreadable,proto3" json:"unreadable,omitempty"`
+	UnreadableReason string `protobuf:"bytes,12,opt,name=unreadable_reason,json=unreadableReason,proto3" json:"unreadable_reason,omitempty"`
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1053,13 +1643,13 @@ func (x *GetSnapshotOut) Reset() {
 	}
 }
 
-func (x *GetSnapshotOut) String() string {
+func (x *Value) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSnapshotOut) ProtoMessage() {}
+func (*Value) ProtoMessage() {}
 
-func (x *GetSnapshotOut) ProtoReflect() protoreflect.Message {
+func (x *Value) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1071,99 +1661,129 @@ func (x *GetSnapshotOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSnapshotOut.ProtoReflect.Descriptor instead.
-func (*GetSnapshotOut) Descriptor() ([]byte, []int) {
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetSnapshotOut) GetProfile() *Profile {
+func (x *Value) GetName() string {
 	if x != nil {
-		return x.Profile
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSnapshotOut) GetFrameData() []*FrameData {
+func (x *Value) GetKind() string {
 	if x != nil {
-		return x.FrameData
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-type ListProcessesIn struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The results are processes that match one or more of these predicates.
-	Predicates []*ListProcessesIn_TargetSpec `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+func (x *Value) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *ListProcessesIn) Reset() {
-	*x = ListProcessesIn{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Value) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
+	return ""
 }
 
-func (x *ListProcessesIn) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Value) GetAddress() uint64 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
 }
 
-func (*ListProcessesIn) ProtoMessage() {}
+func (x *Value) GetPointerAddress() uint64 {
+	if x != nil {
+		return x.PointerAddress
+	}
+	return 0
+}
 
-func (x *ListProcessesIn) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Value) GetLen() int64 {
+	if x != nil {
+		return x.Len
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListProcessesIn.ProtoReflect.Descriptor instead.
-func (*ListProcessesIn) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{17}
+func (x *Value) GetCap() int64 {
+	if x != nil {
+		return x.Cap
+	}
+	return 0
 }
 
-func (x *ListProcessesIn) GetPredicates() []*ListProcessesIn_TargetSpec {
+func (x *Value) GetChildren() []*Value {
 	if x != nil {
-		return x.Predicates
+		return x.Children
 	}
 	return nil
 }
 
-type ListProcessesOut struct {
+func (x *Value) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *Value) GetUnreadable() bool {
+	if x != nil {
+		return x.Unreadable
+	}
+	return false
+}
+
+func (x *Value) GetUnreadableReason() string {
+	if x != nil {
+		return x.UnreadableReason
+	}
+	return ""
+}
+
+type CapturedExpression struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Reports contains an entry for every connected agent.
-	Reports []*AgentReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// value is a single-line rendering of the expression's value. See
+	// structured_value for a representation fit for programmatic consumption.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// structured_value is the expression's value as a tree.
+	StructuredValue *Value `protobuf:"bytes,3,opt,name=structured_value,json=structuredValue,proto3" json:"structured_value,omitempty"`
+	// error is set if evaluating the expression failed (e.g. because of a nil
+	// pointer dereference, or because the variable was optimized away). In that
+	// case, value and structured_value are not set.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ListProcessesOut) Reset() {
-	*x = ListProcessesOut{}
+func (x *CapturedExpression) Reset() {
+	*x = CapturedExpression{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[18]
+		mi := &file_rpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListProcessesOut) String() string {
+func (x *CapturedExpression) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProcessesOut) ProtoMessage() {}
+func (*CapturedExpression) ProtoMessage() {}
 
-func (x *ListProcessesOut) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[18]
+func (x *CapturedExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,70 +1794,79 @@ func (x *ListProcessesOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProcessesOut.ProtoReflect.Descriptor instead.
-func (*ListProcessesOut) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CapturedExpression.ProtoReflect.Descriptor instead.
+func (*CapturedExpression) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListProcessesOut) GetReports() []*AgentReport {
+func (x *CapturedExpression) GetExpression() string {
 	if x != nil {
-		return x.Reports
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *CapturedExpression) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CapturedExpression) GetStructuredValue() *Value {
+	if x != nil {
+		return x.StructuredValue
 	}
 	return nil
 }
 
-// AgentReport is the information reported by a single agent about the processes
-// of interest it recognized on its host.
-type AgentReport struct {
+func (x *CapturedExpression) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ExpressionSummary summarizes the evaluations of one of the expressions in a
+// FrameSpec across all the frames where it was evaluated.
+type ExpressionSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the host where the agent is running.
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	// The IP addresses of the host where the agent is running.
-	IpAddress    [][]byte `protobuf:"bytes,2,rep,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	AgentVersion string   `protobuf:"bytes,3,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
-	// processes can be empty if the agent did not find any processes matching the
-	// predicates in the query.
-	Processes []*Process `protobuf:"bytes,4,rep,name=processes,proto3" json:"processes,omitempty"`
-	// agent_id is a unique identifier for the agent. The ID is referenced by the
-	// CaptureSnapshot RPC in order to identify which machine to collect process
-	// snapshots from. If the server has a stable, unique identifier for an agent
-	// (e.g. if the agent's IP or hostname are unique), then it will use that. If
-	// two agents cannot be distinguished by the server (e.g. if two agents are
-	// running on the same machine, probably by mistake), then the server will
-	// generate synthetic identifiers. In this case, however, the IDs are not
-	// usable after a server restart (i.e. CaptureSnapshot RPCs using them will
-	// fail).
-	//
-	// TODO: Ideally, such an agent identifier would not make it to the client. If
-	// the client does not have another unique way to identify between two or more
-	// agents (e.g. IP, hostname, PID of target process running on that agent's
-	// machine, etc.), then the ultimate human user also has no way to
-	// disambiguate between the respective agents, and thus they shouldn't be
-	// asked to. The server should thus find ways to deal with indistinguishable
-	// agents in a way that is transparent to the client.
-	AgentId string `protobuf:"bytes,5,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-}
-
-func (x *AgentReport) Reset() {
-	*x = AgentReport{}
+	// func_name identifies the FrameSpec that the expression belongs to.
+	FuncName   string `protobuf:"bytes,1,opt,name=func_name,json=funcName,proto3" json:"func_name,omitempty"`
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// num_evaluated is the number of frames in which the expression was
+	// evaluated.
+	NumEvaluated int32 `protobuf:"varint,3,opt,name=num_evaluated,json=numEvaluated,proto3" json:"num_evaluated,omitempty"`
+	// num_failed is the number of evaluations that failed.
+	NumFailed int32 `protobuf:"varint,4,opt,name=num_failed,json=numFailed,proto3" json:"num_failed,omitempty"`
+	// first_error is the error encountered by one of the failed evaluations, if
+	// any.
+	FirstError string `protobuf:"bytes,5,opt,name=first_error,json=firstError,proto3" json:"first_error,omitempty"`
+	// extractor is set, instead of func_name, for the expressions of context
+	// extractors. It is the extractor's name.
+	Extractor string `protobuf:"bytes,6,opt,name=extractor,proto3" json:"extractor,omitempty"`
+}
+
+func (x *ExpressionSummary) Reset() {
+	*x = ExpressionSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[19]
+		mi := &file_rpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AgentReport) String() string {
+func (x *ExpressionSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentReport) ProtoMessage() {}
+func (*ExpressionSummary) ProtoMessage() {}
 
-func (x *AgentReport) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[19]
+func (x *ExpressionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,61 +1877,150 @@ func (x *AgentReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentReport.ProtoReflect.Descriptor instead.
-func (*AgentReport) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ExpressionSummary.ProtoReflect.Descriptor instead.
+func (*ExpressionSummary) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AgentReport) GetHostname() string {
+func (x *ExpressionSummary) GetFuncName() string {
 	if x != nil {
-		return x.Hostname
+		return x.FuncName
 	}
 	return ""
 }
 
-func (x *AgentReport) GetIpAddress() [][]byte {
+func (x *ExpressionSummary) GetExpression() string {
 	if x != nil {
-		return x.IpAddress
+		return x.Expression
 	}
-	return nil
+	return ""
 }
 
-func (x *AgentReport) GetAgentVersion() string {
+func (x *ExpressionSummary) GetNumEvaluated() int32 {
 	if x != nil {
-		return x.AgentVersion
+		return x.NumEvaluated
 	}
-	return ""
+	return 0
 }
 
-func (x *AgentReport) GetProcesses() []*Process {
+func (x *ExpressionSummary) GetNumFailed() int32 {
 	if x != nil {
-		return x.Processes
+		return x.NumFailed
 	}
-	return nil
+	return 0
 }
 
-func (x *AgentReport) GetAgentId() string {
+func (x *ExpressionSummary) GetFirstError() string {
 	if x != nil {
-		return x.AgentId
+		return x.FirstError
 	}
 	return ""
 }
 
-type Process struct {
+func (x *ExpressionSummary) GetExtractor() string {
+	if x != nil {
+		return x.Extractor
+	}
+	return ""
+}
+
+// FrameData represents the data captured for a single stack frame.
+type FrameData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pid     int32    `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
-	Binary  *Binary  `protobuf:"bytes,2,opt,name=binary,proto3" json:"binary,omitempty"`
-	Command [][]byte `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
-	// The index(es) of the TargetSpec that matched this process within the
-	// ListProcessesIn.predicates.
-	MatchIdx int32 `protobuf:"varint,4,opt,name=match_idx,json=matchIdx,proto3" json:"match_idx,omitempty"`
+	GoroutineId int64 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	// The index of the frame in the stack. 0 is the leaf function.
+	FrameIdx      int64                 `protobuf:"varint,2,opt,name=frame_idx,json=frameIdx,proto3" json:"frame_idx,omitempty"`
+	CapturedExprs []*CapturedExpression `protobuf:"bytes,3,rep,name=captured_exprs,json=capturedExprs,proto3" json:"captured_exprs,omitempty"`
 }
 
-func (x *Process) Reset() {
-	*x = Process{}
+func (x *FrameData) Reset() {
+	*x = FrameData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameData) ProtoMessage() {}
+
+func (x *FrameData) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameData.ProtoReflect.Descriptor instead.
+func (*FrameData) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FrameData) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *FrameData) GetFrameIdx() int64 {
+	if x != nil {
+		return x.FrameIdx
+	}
+	return 0
+}
+
+func (x *FrameData) GetCapturedExprs() []*CapturedExpression {
+	if x != nil {
+		return x.CapturedExprs
+	}
+	return nil
+}
+
+type GetSnapshotOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// // Map from goroutine ID to map from frame index to array of captured values.
+	// // The frame indexes match the order in Stacks - from leaf function to
+	// // callers.
+	FrameData []*FrameData `protobuf:"bytes,2,rep,name=frame_data,json=frameData,proto3" json:"frame_data,omitempty"`
+	// expression_summaries contains an entry for every expression in the
+	// request's frame_specs that was evaluated at least once.
+	ExpressionSummaries []*ExpressionSummary `protobuf:"bytes,3,rep,name=expression_summaries,json=expressionSummaries,proto3" json:"expression_summaries,omitempty"`
+	// num_truncated_goroutines is the number of goroutines whose stacks were
+	// truncated because they were deeper than max_stack_depth.
+	NumTruncatedGoroutines int32 `protobuf:"varint,4,opt,name=num_truncated_goroutines,json=numTruncatedGoroutines,proto3" json:"num_truncated_goroutines,omitempty"`
+	// scripts describes the scripts that were run in order to produce the
+	// snapshot.
+	Scripts []*ScriptInfo `protobuf:"bytes,5,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	// groups contains the goroutines grouped by the values of the request's
+	// group_by expressions, ordered by decreasing number of goroutines. Empty if
+	// group_by is empty.
+	Groups []*ValueGroup `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+	// goroutines contains a record for every goroutine, ordered by ID.
+	Goroutines []*GoroutineInfo `protobuf:"bytes,7,rep,name=goroutines,proto3" json:"goroutines,omitempty"`
+	// num_label_read_failures is the number of goroutines whose pprof labels
+	// could not be read. These goroutines are treated as having no labels.
+	NumLabelReadFailures int32 `protobuf:"varint,8,opt,name=num_label_read_failures,json=numLabelReadFailures,proto3" json:"num_label_read_failures,omitempty"`
+}
+
+func (x *GetSnapshotOut) Reset() {
+	*x = GetSnapshotOut{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1310,13 +2028,13 @@ func (x *Process) Reset() {
 	}
 }
 
-func (x *Process) String() string {
+func (x *GetSnapshotOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Process) ProtoMessage() {}
+func (*GetSnapshotOut) ProtoMessage() {}
 
-func (x *Process) ProtoReflect() protoreflect.Message {
+func (x *GetSnapshotOut) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1328,52 +2046,104 @@ func (x *Process) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Process.ProtoReflect.Descriptor instead.
-func (*Process) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSnapshotOut.ProtoReflect.Descriptor instead.
+func (*GetSnapshotOut) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Process) GetPid() int32 {
+func (x *GetSnapshotOut) GetProfile() *Profile {
 	if x != nil {
-		return x.Pid
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetSnapshotOut) GetFrameData() []*FrameData {
+	if x != nil {
+		return x.FrameData
+	}
+	return nil
+}
+
+func (x *GetSnapshotOut) GetExpressionSummaries() []*ExpressionSummary {
+	if x != nil {
+		return x.ExpressionSummaries
+	}
+	return nil
+}
+
+func (x *GetSnapshotOut) GetNumTruncatedGoroutines() int32 {
+	if x != nil {
+		return x.NumTruncatedGoroutines
 	}
 	return 0
 }
 
-func (x *Process) GetBinary() *Binary {
+func (x *GetSnapshotOut) GetScripts() []*ScriptInfo {
 	if x != nil {
-		return x.Binary
+		return x.Scripts
 	}
 	return nil
 }
 
-func (x *Process) GetCommand() [][]byte {
+func (x *GetSnapshotOut) GetGroups() []*ValueGroup {
 	if x != nil {
-		return x.Command
+		return x.Groups
 	}
 	return nil
 }
 
-func (x *Process) GetMatchIdx() int32 {
+func (x *GetSnapshotOut) GetGoroutines() []*GoroutineInfo {
 	if x != nil {
-		return x.MatchIdx
+		return x.Goroutines
+	}
+	return nil
+}
+
+func (x *GetSnapshotOut) GetNumLabelReadFailures() int32 {
+	if x != nil {
+		return x.NumLabelReadFailures
 	}
 	return 0
 }
 
-type Binary struct {
+// GoroutineInfo describes a goroutine, independently of its stack.
+type GoroutineInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// An identifier for the binary. The same binary will result in the same
-	// identifier every time.
-	ID   []byte `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Path []byte `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-}
-
-func (x *Binary) Reset() {
-	*x = Binary{}
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status is the name of the goroutine's scheduling status (e.g. "running",
+	// "waiting").
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// wait_reason describes what a waiting goroutine is blocked on (e.g. "chan
+	// receive"). Empty for goroutines that are not waiting.
+	WaitReason string `protobuf:"bytes,3,opt,name=wait_reason,json=waitReason,proto3" json:"wait_reason,omitempty"`
+	// wait_duration_nanos is approximately how long the goroutine has been
+	// waiting. 0 if unknown; the runtime only records when a goroutine started
+	// waiting as part of garbage collections.
+	WaitDurationNanos int64 `protobuf:"varint,4,opt,name=wait_duration_nanos,json=waitDurationNanos,proto3" json:"wait_duration_nanos,omitempty"`
+	// created_by is the location of the go statement that created the
+	// goroutine.
+	CreatedBy *StackFrame `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// parent_id is the ID of the goroutine that created this one. 0 if unknown;
+	// the runtime tracks it starting with Go 1.21.
+	ParentId int64 `protobuf:"varint,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// thread_id is the ID of the OS thread running the goroutine. 0 if the
+	// goroutine is not running.
+	ThreadId int64 `protobuf:"varint,7,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	// labels are the goroutine's pprof labels, as set by pprof.Do() or
+	// pprof.SetGoroutineLabels(). They are also attached to the goroutine's
+	// samples in the profile, like in the runtime's goroutine profile. The
+	// labels are only read if the request has group_by or label_expressions.
+	Labels map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// start_function is the function that the goroutine started with.
+	StartFunction string `protobuf:"bytes,9,opt,name=start_function,json=startFunction,proto3" json:"start_function,omitempty"`
+}
+
+func (x *GoroutineInfo) Reset() {
+	*x = GoroutineInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1381,13 +2151,13 @@ func (x *Binary) Reset() {
 	}
 }
 
-func (x *Binary) String() string {
+func (x *GoroutineInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Binary) ProtoMessage() {}
+func (*GoroutineInfo) ProtoMessage() {}
 
-func (x *Binary) ProtoReflect() protoreflect.Message {
+func (x *GoroutineInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1399,40 +2169,93 @@ func (x *Binary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Binary.ProtoReflect.Descriptor instead.
-func (*Binary) Descriptor() ([]byte, []int) {
+// Deprecated: Use GoroutineInfo.ProtoReflect.Descriptor instead.
+func (*GoroutineInfo) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Binary) GetID() []byte {
+func (x *GoroutineInfo) GetId() int64 {
 	if x != nil {
-		return x.ID
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoroutineInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GoroutineInfo) GetWaitReason() string {
+	if x != nil {
+		return x.WaitReason
+	}
+	return ""
+}
+
+func (x *GoroutineInfo) GetWaitDurationNanos() int64 {
+	if x != nil {
+		return x.WaitDurationNanos
+	}
+	return 0
+}
+
+func (x *GoroutineInfo) GetCreatedBy() *StackFrame {
+	if x != nil {
+		return x.CreatedBy
 	}
 	return nil
 }
 
-func (x *Binary) GetPath() []byte {
+func (x *GoroutineInfo) GetParentId() int64 {
 	if x != nil {
-		return x.Path
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *GoroutineInfo) GetThreadId() int64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *GoroutineInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
 	}
 	return nil
 }
 
-type DownloadBinaryIn struct {
+func (x *GoroutineInfo) GetStartFunction() string {
+	if x != nil {
+		return x.StartFunction
+	}
+	return ""
+}
+
+// ValueGroup is a group of goroutines for which the group_by expressions of a
+// GetSnapshotIn have the same values.
+type ValueGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// binary_id identifies the binary to make durable.
-	BinaryId []byte `protobuf:"bytes,1,opt,name=binary_id,json=binaryId,proto3" json:"binary_id,omitempty"`
-	// processes_config has information useful for finding the binary. The
-	// assumption is that the binary corresponds to one or more processes returned
-	// by a ListProcesses(process_config) call.
-	ProcessesConfig *ListProcessesIn `protobuf:"bytes,2,opt,name=processes_config,json=processesConfig,proto3" json:"processes_config,omitempty"`
+	// values are the values of the group_by expressions, in order. The value of
+	// an expression that was not captured for the group's goroutines is
+	// "<not captured>". Strings are not quoted. Values that were cut short when
+	// they were read (according to the expression's load config) end with
+	// "..."; goroutines whose values only differ past that point share a group.
+	Values        []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	NumGoroutines int32    `protobuf:"varint,2,opt,name=num_goroutines,json=numGoroutines,proto3" json:"num_goroutines,omitempty"`
+	GoroutineIds  []int64  `protobuf:"varint,3,rep,packed,name=goroutine_ids,json=goroutineIds,proto3" json:"goroutine_ids,omitempty"`
 }
 
-func (x *DownloadBinaryIn) Reset() {
-	*x = DownloadBinaryIn{}
+func (x *ValueGroup) Reset() {
+	*x = ValueGroup{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1440,13 +2263,13 @@ func (x *DownloadBinaryIn) Reset() {
 	}
 }
 
-func (x *DownloadBinaryIn) String() string {
+func (x *ValueGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadBinaryIn) ProtoMessage() {}
+func (*ValueGroup) ProtoMessage() {}
 
-func (x *DownloadBinaryIn) ProtoReflect() protoreflect.Message {
+func (x *ValueGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1458,33 +2281,53 @@ func (x *DownloadBinaryIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadBinaryIn.ProtoReflect.Descriptor instead.
-func (*DownloadBinaryIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValueGroup.ProtoReflect.Descriptor instead.
+func (*ValueGroup) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DownloadBinaryIn) GetBinaryId() []byte {
+func (x *ValueGroup) GetValues() []string {
 	if x != nil {
-		return x.BinaryId
+		return x.Values
 	}
 	return nil
 }
 
-func (x *DownloadBinaryIn) GetProcessesConfig() *ListProcessesIn {
+func (x *ValueGroup) GetNumGoroutines() int32 {
 	if x != nil {
-		return x.ProcessesConfig
+		return x.NumGoroutines
+	}
+	return 0
+}
+
+func (x *ValueGroup) GetGoroutineIds() []int64 {
+	if x != nil {
+		return x.GoroutineIds
 	}
 	return nil
 }
 
-type DownloadBinaryOut struct {
+// ScriptInfo describes one of the Starlark scripts that the agent runs in the
+// target.
+type ScriptInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *DownloadBinaryOut) Reset() {
-	*x = DownloadBinaryOut{}
+	// name is the script's file name, e.g. "walk_stacks.star".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version identifies the script's source. It is derived from a hash of the
+	// source, so it changes whenever the script changes.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// overridden is set if the script was loaded from the agent's override
+	// directory instead of being the one embedded in the agent.
+	Overridden bool `protobuf:"varint,3,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	// path is the file the script was loaded from, if overridden is set.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ScriptInfo) Reset() {
+	*x = ScriptInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1492,13 +2335,13 @@ func (x *DownloadBinaryOut) Reset() {
 	}
 }
 
-func (x *DownloadBinaryOut) String() string {
+func (x *ScriptInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadBinaryOut) ProtoMessage() {}
+func (*ScriptInfo) ProtoMessage() {}
 
-func (x *DownloadBinaryOut) ProtoReflect() protoreflect.Message {
+func (x *ScriptInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1510,369 +2353,6582 @@ func (x *DownloadBinaryOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadBinaryOut.ProtoReflect.Descriptor instead.
-func (*DownloadBinaryOut) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScriptInfo.ProtoReflect.Descriptor instead.
+func (*ScriptInfo) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{23}
 }
 
-// TargetSpec defines a predicate for matching processes. All present fields
-// are ANDed together.
-type ListProcessesIn_TargetSpec struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Hostname    string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	ProcessName string `protobuf:"bytes,2,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
-	BinaryPath  string `protobuf:"bytes,3,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`
+func (x *ScriptInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ListProcessesIn_TargetSpec) Reset() {
-	*x = ListProcessesIn_TargetSpec{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ScriptInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
+	return ""
 }
 
-func (x *ListProcessesIn_TargetSpec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScriptInfo) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
 }
 
-func (*ListProcessesIn_TargetSpec) ProtoMessage() {}
-
-func (x *ListProcessesIn_TargetSpec) ProtoReflect() protoreflect.Message {
+func (x *ScriptInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ListScriptsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListScriptsIn) Reset() {
+	*x = ListScriptsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScriptsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScriptsIn) ProtoMessage() {}
+
+func (x *ListScriptsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScriptsIn.ProtoReflect.Descriptor instead.
+func (*ListScriptsIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{24}
+}
+
+type ListScriptsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scripts []*ScriptInfo `protobuf:"bytes,1,rep,name=scripts,proto3" json:"scripts,omitempty"`
+}
+
+func (x *ListScriptsOut) Reset() {
+	*x = ListScriptsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScriptsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScriptsOut) ProtoMessage() {}
+
+func (x *ListScriptsOut) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListProcessesIn_TargetSpec.ProtoReflect.Descriptor instead.
-func (*ListProcessesIn_TargetSpec) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{17, 0}
-}
-
-func (x *ListProcessesIn_TargetSpec) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
-}
-
-func (x *ListProcessesIn_TargetSpec) GetProcessName() string {
-	if x != nil {
-		return x.ProcessName
-	}
-	return ""
-}
-
-func (x *ListProcessesIn_TargetSpec) GetBinaryPath() string {
-	if x != nil {
-		return x.BinaryPath
-	}
-	return ""
-}
-
-var File_rpc_proto protoreflect.FileDescriptor
-
-var file_rpc_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x72, 0x70, 0x63, 0x1a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x63, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74,
-	0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x62, 0x65,
-	0x64, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6d, 0x62, 0x65,
-	0x64, 0x64, 0x65, 0x64, 0x22, 0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x07, 0x56, 0x61, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x19, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79,
-	0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c,
-	0x6f, 0x63, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22,
-	0x96, 0x01, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x61, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
-	0x2b, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x4e,
-	0x6f, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
-	0x74, 0x56, 0x61, 0x72, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x63, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x63, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x30,
-	0x0a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x74, 0x79,
-	0x70, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0xba, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x72, 0x73, 0x4f, 0x75, 0x74,
-	0x12, 0x25, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a,
-	0x4c, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a,
-	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x28, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x46, 0x75, 0x6e, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x46, 0x75, 0x6e, 0x63, 0x73, 0x22, 0x58, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0x24, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x6a, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a,
-	0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x65,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x95, 0x01,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x12,
-	0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0b,
-	0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x61,
-	0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x65,
-	0x63, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x73, 0x22, 0x4a, 0x0a, 0x12, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
-	0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x90, 0x01, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x21, 0x0a, 0x0c, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x78, 0x12,
-	0x43, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x45,
-	0x78, 0x70, 0x72, 0x73, 0x22, 0x7b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x74, 0x6f,
-	0x6f, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x32, 0x0a,
-	0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x61,
-	0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x49, 0x6e, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
-	0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0a, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
-	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
-	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x22, 0x43, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a,
-	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xb9,
-	0x01, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09,
-	0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
-	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
-	0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x07, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x78, 0x22, 0x2c, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x75, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69,
-	0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62,
-	0x69, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x52, 0x0f, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x13, 0x0a,
-	0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4f,
-	0x75, 0x74, 0x32, 0x9f, 0x03, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0e, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x1a, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49,
-	0x6e, 0x1a, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x49,
-	0x6e, 0x1a, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x49,
-	0x6e, 0x1a, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x4c,
-	0x69, 0x73, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x15, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x72,
-	0x73, 0x4f, 0x75, 0x74, 0x32, 0x53, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x1a,
-	0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4f, 0x75, 0x74, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x64, 0x72, 0x65, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x69, 0x2f, 0x64, 0x65, 0x6c, 0x76, 0x65, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_rpc_proto_rawDescOnce sync.Once
-	file_rpc_proto_rawDescData = file_rpc_proto_rawDesc
-)
-
-func file_rpc_proto_rawDescGZIP() []byte {
-	file_rpc_proto_rawDescOnce.Do(func() {
-		file_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_proto_rawDescData)
-	})
-	return file_rpc_proto_rawDescData
-}
-
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
-var file_rpc_proto_goTypes = []interface{}{
-	(*GetTypeInfoIn)(nil),              // 0: agentrpc.GetTypeInfoIn
-	(*FieldInfo)(nil),                  // 1: agentrpc.FieldInfo
-	(*GetTypeInfoOut)(nil),             // 2: agentrpc.GetTypeInfoOut
-	(*VarInfo)(nil),                    // 3: agentrpc.VarInfo
-	(*TypeInfo)(nil),                   // 4: agentrpc.TypeInfo
-	(*ListVarsIn)(nil),                 // 5: agentrpc.ListVarsIn
-	(*ListVarsOut)(nil),                // 6: agentrpc.ListVarsOut
-	(*ListFunctionsIn)(nil),            // 7: agentrpc.ListFunctionsIn
-	(*ListFunctionsOut)(nil),           // 8: agentrpc.ListFunctionsOut
-	(*ListTypesIn)(nil),                // 9: agentrpc.ListTypesIn
-	(*ListTypesOut)(nil),               // 10: agentrpc.ListTypesOut
-	(*FrameSpec)(nil),                  // 11: agentrpc.FrameSpec
-	(*TypeSpec)(nil),                   // 12: agentrpc.TypeSpec
-	(*GetSnapshotIn)(nil),              // 13: agentrpc.GetSnapshotIn
-	(*CapturedExpression)(nil),         // 14: agentrpc.CapturedExpression
-	(*FrameData)(nil),                  // 15: agentrpc.FrameData
-	(*GetSnapshotOut)(nil),             // 16: agentrpc.GetSnapshotOut
-	(*ListProcessesIn)(nil),            // 17: agentrpc.ListProcessesIn
-	(*ListProcessesOut)(nil),           // 18: agentrpc.ListProcessesOut
-	(*AgentReport)(nil),                // 19: agentrpc.AgentReport
-	(*Process)(nil),                    // 20: agentrpc.Process
-	(*Binary)(nil),                     // 21: agentrpc.Binary
-	(*DownloadBinaryIn)(nil),           // 22: agentrpc.DownloadBinaryIn
-	(*DownloadBinaryOut)(nil),          // 23: agentrpc.DownloadBinaryOut
-	nil,                                // 24: agentrpc.ListVarsOut.TypesEntry
-	(*ListProcessesIn_TargetSpec)(nil), // 25: agentrpc.ListProcessesIn.TargetSpec
-	(*Profile)(nil),                    // 26: perftools.profiles.Profile
-}
-var file_rpc_proto_depIdxs = []int32{
-	1,  // 0: agentrpc.GetTypeInfoOut.fields:type_name -> agentrpc.FieldInfo
-	1,  // 1: agentrpc.TypeInfo.fields:type_name -> agentrpc.FieldInfo
-	3,  // 2: agentrpc.ListVarsOut.vars:type_name -> agentrpc.VarInfo
-	24, // 3: agentrpc.ListVarsOut.types:type_name -> agentrpc.ListVarsOut.TypesEntry
-	11, // 4: agentrpc.GetSnapshotIn.frame_specs:type_name -> agentrpc.FrameSpec
-	12, // 5: agentrpc.GetSnapshotIn.type_specs:type_name -> agentrpc.TypeSpec
-	14, // 6: agentrpc.FrameData.captured_exprs:type_name -> agentrpc.CapturedExpression
-	26, // 7: agentrpc.GetSnapshotOut.profile:type_name -> perftools.profiles.Profile
-	15, // 8: agentrpc.GetSnapshotOut.frame_data:type_name -> agentrpc.FrameData
-	25, // 9: agentrpc.ListProcessesIn.predicates:type_name -> agentrpc.ListProcessesIn.TargetSpec
-	19, // 10: agentrpc.ListProcessesOut.reports:type_name -> agentrpc.AgentReport
-	20, // 11: agentrpc.AgentReport.processes:type_name -> agentrpc.Process
-	21, // 12: agentrpc.Process.binary:type_name -> agentrpc.Binary
-	17, // 13: agentrpc.DownloadBinaryIn.processes_config:type_name -> agentrpc.ListProcessesIn
-	4,  // 14: agentrpc.ListVarsOut.TypesEntry.value:type_name -> agentrpc.TypeInfo
-	17, // 15: agentrpc.DebugInfo.ListProcesses:input_type -> agentrpc.ListProcessesIn
-	22, // 16: agentrpc.DebugInfo.DownloadBinary:input_type -> agentrpc.DownloadBinaryIn
-	7,  // 17: agentrpc.DebugInfo.ListFunctions:input_type -> agentrpc.ListFunctionsIn
-	9,  // 18: agentrpc.DebugInfo.ListTypes:input_type -> agentrpc.ListTypesIn
-	0,  // 19: agentrpc.DebugInfo.GetTypeInfo:input_type -> agentrpc.GetTypeInfoIn
-	5,  // 20: agentrpc.DebugInfo.ListVars:input_type -> agentrpc.ListVarsIn
-	13, // 21: agentrpc.SnapshotService.GetSnapshot:input_type -> agentrpc.GetSnapshotIn
-	18, // 22: agentrpc.DebugInfo.ListProcesses:output_type -> agentrpc.ListProcessesOut
-	23, // 23: agentrpc.DebugInfo.DownloadBinary:output_type -> agentrpc.DownloadBinaryOut
-	8,  // 24: agentrpc.DebugInfo.ListFunctions:output_type -> agentrpc.ListFunctionsOut
-	10, // 25: agentrpc.DebugInfo.ListTypes:output_type -> agentrpc.ListTypesOut
-	2,  // 26: agentrpc.DebugInfo.GetTypeInfo:output_type -> agentrpc.GetTypeInfoOut
-	6,  // 27: agentrpc.DebugInfo.ListVars:output_type -> agentrpc.ListVarsOut
-	16, // 28: agentrpc.SnapshotService.GetSnapshot:output_type -> agentrpc.GetSnapshotOut
-	22, // [22:29] is the sub-list for method output_type
-	15, // [15:22] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
-}
-
-func init() { file_rpc_proto_init() }
-func file_rpc_proto_init() {
-	if File_rpc_proto != nil {
-		return
-	}
-	file_profile_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTypeInfoIn); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScriptsOut.ProtoReflect.Descriptor instead.
+func (*ListScriptsOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListScriptsOut) GetScripts() []*ScriptInfo {
+	if x != nil {
+		return x.Scripts
+	}
+	return nil
+}
+
+type WalkContextIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoroutineId int64 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	// frame_idx is the index of the frame in the goroutine's stack, as in
+	// FrameData. 0 is the leaf function.
+	FrameIdx int64 `protobuf:"varint,2,opt,name=frame_idx,json=frameIdx,proto3" json:"frame_idx,omitempty"`
+	// expression is evaluated in the frame's scope to produce the
+	// context.Context to walk. Defaults to "ctx".
+	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *WalkContextIn) Reset() {
+	*x = WalkContextIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkContextIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkContextIn) ProtoMessage() {}
+
+func (x *WalkContextIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkContextIn.ProtoReflect.Descriptor instead.
+func (*WalkContextIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WalkContextIn) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *WalkContextIn) GetFrameIdx() int64 {
+	if x != nil {
+		return x.FrameIdx
+	}
+	return 0
+}
+
+func (x *WalkContextIn) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// ContextNode describes one of the contexts in a context chain.
+type ContextNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the context's concrete type, e.g. "*context.valueCtx".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// error is set if the context could not be read. The walk stops at such a
+	// context.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ContextNode) Reset() {
+	*x = ContextNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContextNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContextNode) ProtoMessage() {}
+
+func (x *ContextNode) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContextNode.ProtoReflect.Descriptor instead.
+func (*ContextNode) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ContextNode) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContextNode) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ContextValue is a key/value pair stored in a context chain through
+// context.WithValue().
+type ContextValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// key_type is the concrete type of the key.
+	KeyType string `protobuf:"bytes,2,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	Value   string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// value_type is the concrete type of the value.
+	ValueType string `protobuf:"bytes,4,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+}
+
+func (x *ContextValue) Reset() {
+	*x = ContextValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContextValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContextValue) ProtoMessage() {}
+
+func (x *ContextValue) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContextValue.ProtoReflect.Descriptor instead.
+func (*ContextValue) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ContextValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ContextValue) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *ContextValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ContextValue) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+type WalkContextOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chain lists the contexts in the chain, starting with the walked context
+	// and ending with the root context (e.g. context.Background()).
+	Chain []*ContextNode `protobuf:"bytes,1,rep,name=chain,proto3" json:"chain,omitempty"`
+	// values contains the key/value pairs in the chain, starting with the ones
+	// closest to the walked context. When multiple values have the same key,
+	// ctx.Value() returns the first one.
+	Values []*ContextValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// has_deadline is set if the context has a deadline.
+	HasDeadline bool `protobuf:"varint,3,opt,name=has_deadline,json=hasDeadline,proto3" json:"has_deadline,omitempty"`
+	// deadline_unix_nanos is the context's effective deadline: the earliest
+	// deadline in the chain. Deadlines above a context.WithoutCancel() context
+	// don't apply.
+	DeadlineUnixNanos int64 `protobuf:"varint,4,opt,name=deadline_unix_nanos,json=deadlineUnixNanos,proto3" json:"deadline_unix_nanos,omitempty"`
+	// time_remaining_nanos is the time left until the deadline, as of when the
+	// context was walked. Negative if the deadline has passed.
+	TimeRemainingNanos int64 `protobuf:"varint,5,opt,name=time_remaining_nanos,json=timeRemainingNanos,proto3" json:"time_remaining_nanos,omitempty"`
+	// cancelled is set if the context has been cancelled. The cancellation of
+	// the contexts above a context.WithoutCancel() context doesn't apply.
+	Cancelled bool `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// err is what the context's Err() returns, if cancelled is set.
+	Err string `protobuf:"bytes,7,opt,name=err,proto3" json:"err,omitempty"`
+	// cause is the cancellation cause, if cancelled is set and a cause was
+	// provided.
+	Cause string `protobuf:"bytes,8,opt,name=cause,proto3" json:"cause,omitempty"`
+	// truncated is set if the chain was longer than the agent's limit, in which
+	// case only the contexts closest to the walked one were inspected.
+	Truncated bool `protobuf:"varint,9,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *WalkContextOut) Reset() {
+	*x = WalkContextOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkContextOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkContextOut) ProtoMessage() {}
+
+func (x *WalkContextOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkContextOut.ProtoReflect.Descriptor instead.
+func (*WalkContextOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WalkContextOut) GetChain() []*ContextNode {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+func (x *WalkContextOut) GetValues() []*ContextValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *WalkContextOut) GetHasDeadline() bool {
+	if x != nil {
+		return x.HasDeadline
+	}
+	return false
+}
+
+func (x *WalkContextOut) GetDeadlineUnixNanos() int64 {
+	if x != nil {
+		return x.DeadlineUnixNanos
+	}
+	return 0
+}
+
+func (x *WalkContextOut) GetTimeRemainingNanos() int64 {
+	if x != nil {
+		return x.TimeRemainingNanos
+	}
+	return 0
+}
+
+func (x *WalkContextOut) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *WalkContextOut) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+func (x *WalkContextOut) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *WalkContextOut) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type FindGoroutinesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// frame_specs and context_extractors describe the values captured from the
+	// goroutines, like in GetSnapshotIn.
+	FrameSpecs        []*FrameSpec        `protobuf:"bytes,1,rep,name=frame_specs,json=frameSpecs,proto3" json:"frame_specs,omitempty"`
+	ContextExtractors []*ContextExtractor `protobuf:"bytes,2,rep,name=context_extractors,json=contextExtractors,proto3" json:"context_extractors,omitempty"`
+	// value is the value searched for. It is compared to the values of the
+	// captured expressions according to match_mode. String values are compared
+	// without quotes; a string that was truncated when it was read never matches
+	// exactly.
+	Value     string                     `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	MatchMode FindGoroutinesIn_MatchMode `protobuf:"varint,4,opt,name=match_mode,json=matchMode,proto3,enum=agentrpc.FindGoroutinesIn_MatchMode" json:"match_mode,omitempty"`
+	// expressions, if not empty, restricts the search to the values captured for
+	// these expressions of the frame specs, or for the context extractors with
+	// these names.
+	Expressions []string `protobuf:"bytes,5,rep,name=expressions,proto3" json:"expressions,omitempty"`
+	// max_stack_depth is like GetSnapshotIn.max_stack_depth.
+	MaxStackDepth int32 `protobuf:"varint,6,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+	// max_results is the maximum number of goroutines returned. 0 means no
+	// limit.
+	MaxResults int32 `protobuf:"varint,7,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+}
+
+func (x *FindGoroutinesIn) Reset() {
+	*x = FindGoroutinesIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindGoroutinesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindGoroutinesIn) ProtoMessage() {}
+
+func (x *FindGoroutinesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindGoroutinesIn.ProtoReflect.Descriptor instead.
+func (*FindGoroutinesIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FindGoroutinesIn) GetFrameSpecs() []*FrameSpec {
+	if x != nil {
+		return x.FrameSpecs
+	}
+	return nil
+}
+
+func (x *FindGoroutinesIn) GetContextExtractors() []*ContextExtractor {
+	if x != nil {
+		return x.ContextExtractors
+	}
+	return nil
+}
+
+func (x *FindGoroutinesIn) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FindGoroutinesIn) GetMatchMode() FindGoroutinesIn_MatchMode {
+	if x != nil {
+		return x.MatchMode
+	}
+	return FindGoroutinesIn_EXACT
+}
+
+func (x *FindGoroutinesIn) GetExpressions() []string {
+	if x != nil {
+		return x.Expressions
+	}
+	return nil
+}
+
+func (x *FindGoroutinesIn) GetMaxStackDepth() int32 {
+	if x != nil {
+		return x.MaxStackDepth
+	}
+	return 0
+}
+
+func (x *FindGoroutinesIn) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+// StackFrame describes a frame of a goroutine's stack.
+type StackFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// function is the fully qualified name of the frame's function.
+	Function string `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	File     string `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Line     int64  `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *StackFrame) Reset() {
+	*x = StackFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StackFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StackFrame) ProtoMessage() {}
+
+func (x *StackFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StackFrame.ProtoReflect.Descriptor instead.
+func (*StackFrame) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StackFrame) GetFunction() string {
+	if x != nil {
+		return x.Function
+	}
+	return ""
+}
+
+func (x *StackFrame) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *StackFrame) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+type GetSpawnTreeIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_ancestors is the maximum number of ancestors whose stacks are read for
+	// every goroutine. The runtime only records the ancestors of goroutines if
+	// the target runs with GODEBUG=tracebackancestors=N. If 0, ancestors are not
+	// read, and goroutines are only linked to their parents if the runtime
+	// tracks them (starting with Go 1.21).
+	MaxAncestors int32 `protobuf:"varint,1,opt,name=max_ancestors,json=maxAncestors,proto3" json:"max_ancestors,omitempty"`
+	// max_ancestor_depth is the maximum number of frames read for each ancestor.
+	// If 0, the agent's default is used.
+	MaxAncestorDepth int32 `protobuf:"varint,2,opt,name=max_ancestor_depth,json=maxAncestorDepth,proto3" json:"max_ancestor_depth,omitempty"`
+}
+
+func (x *GetSpawnTreeIn) Reset() {
+	*x = GetSpawnTreeIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpawnTreeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpawnTreeIn) ProtoMessage() {}
+
+func (x *GetSpawnTreeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpawnTreeIn.ProtoReflect.Descriptor instead.
+func (*GetSpawnTreeIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetSpawnTreeIn) GetMaxAncestors() int32 {
+	if x != nil {
+		return x.MaxAncestors
+	}
+	return 0
+}
+
+func (x *GetSpawnTreeIn) GetMaxAncestorDepth() int32 {
+	if x != nil {
+		return x.MaxAncestorDepth
+	}
+	return 0
+}
+
+// SpawnNode is a goroutine in the creation tree.
+type SpawnNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoroutineId int64 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	// created_by is the location of the go statement that created the
+	// goroutine.
+	CreatedBy *StackFrame `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// start_function is the function that the goroutine started with. Empty for
+	// goroutines that have exited.
+	StartFunction string `protobuf:"bytes,3,opt,name=start_function,json=startFunction,proto3" json:"start_function,omitempty"`
+	// exited is set for ancestors of live goroutines that no longer exist. They
+	// are only known from the ancestry information recorded by the runtime.
+	Exited bool `protobuf:"varint,4,opt,name=exited,proto3" json:"exited,omitempty"`
+	// ancestor_stack is the stack of an exited goroutine at the time when it
+	// created its child, as recorded by the runtime.
+	AncestorStack []*StackFrame `protobuf:"bytes,5,rep,name=ancestor_stack,json=ancestorStack,proto3" json:"ancestor_stack,omitempty"`
+	// children are the goroutines created by this one, ordered by ID.
+	Children []*SpawnNode `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	// num_descendants is the number of goroutines in the subtree rooted at this
+	// node, excluding the node itself.
+	NumDescendants int32 `protobuf:"varint,7,opt,name=num_descendants,json=numDescendants,proto3" json:"num_descendants,omitempty"`
+}
+
+func (x *SpawnNode) Reset() {
+	*x = SpawnNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpawnNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnNode) ProtoMessage() {}
+
+func (x *SpawnNode) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnNode.ProtoReflect.Descriptor instead.
+func (*SpawnNode) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SpawnNode) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *SpawnNode) GetCreatedBy() *StackFrame {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *SpawnNode) GetStartFunction() string {
+	if x != nil {
+		return x.StartFunction
+	}
+	return ""
+}
+
+func (x *SpawnNode) GetExited() bool {
+	if x != nil {
+		return x.Exited
+	}
+	return false
+}
+
+func (x *SpawnNode) GetAncestorStack() []*StackFrame {
+	if x != nil {
+		return x.AncestorStack
+	}
+	return nil
+}
+
+func (x *SpawnNode) GetChildren() []*SpawnNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *SpawnNode) GetNumDescendants() int32 {
+	if x != nil {
+		return x.NumDescendants
+	}
+	return 0
+}
+
+// CreationSite is a go statement that created live goroutines.
+type CreationSite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *StackFrame `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// num_goroutines is the number of live goroutines created by the site.
+	NumGoroutines int32 `protobuf:"varint,2,opt,name=num_goroutines,json=numGoroutines,proto3" json:"num_goroutines,omitempty"`
+	// start_functions maps the functions that the goroutines started with to
+	// the number of goroutines.
+	StartFunctions map[string]int32 `protobuf:"bytes,3,rep,name=start_functions,json=startFunctions,proto3" json:"start_functions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *CreationSite) Reset() {
+	*x = CreationSite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreationSite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreationSite) ProtoMessage() {}
+
+func (x *CreationSite) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreationSite.ProtoReflect.Descriptor instead.
+func (*CreationSite) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreationSite) GetLocation() *StackFrame {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreationSite) GetNumGoroutines() int32 {
+	if x != nil {
+		return x.NumGoroutines
+	}
+	return 0
+}
+
+func (x *CreationSite) GetStartFunctions() map[string]int32 {
+	if x != nil {
+		return x.StartFunctions
+	}
+	return nil
+}
+
+type GetSpawnTreeOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// roots are the goroutines whose parents are not known, ordered by ID. They
+	// are the roots of the creation tree.
+	Roots []*SpawnNode `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	// sites contains the creation sites of the live goroutines, ordered by
+	// decreasing number of goroutines.
+	Sites []*CreationSite `protobuf:"bytes,2,rep,name=sites,proto3" json:"sites,omitempty"`
+	// parents_known is set if the goroutines were linked to their parents,
+	// either because the runtime tracks them or because ancestors were read. If
+	// not set, all the goroutines are roots and only sites is meaningful.
+	ParentsKnown  bool  `protobuf:"varint,3,opt,name=parents_known,json=parentsKnown,proto3" json:"parents_known,omitempty"`
+	NumGoroutines int32 `protobuf:"varint,4,opt,name=num_goroutines,json=numGoroutines,proto3" json:"num_goroutines,omitempty"`
+}
+
+func (x *GetSpawnTreeOut) Reset() {
+	*x = GetSpawnTreeOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpawnTreeOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpawnTreeOut) ProtoMessage() {}
+
+func (x *GetSpawnTreeOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpawnTreeOut.ProtoReflect.Descriptor instead.
+func (*GetSpawnTreeOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSpawnTreeOut) GetRoots() []*SpawnNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+func (x *GetSpawnTreeOut) GetSites() []*CreationSite {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+func (x *GetSpawnTreeOut) GetParentsKnown() bool {
+	if x != nil {
+		return x.ParentsKnown
+	}
+	return false
+}
+
+func (x *GetSpawnTreeOut) GetNumGoroutines() int32 {
+	if x != nil {
+		return x.NumGoroutines
+	}
+	return 0
+}
+
+type DetectLeaksIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// num_snapshots is the number of snapshots to take. If 0, the agent's
+	// default is used. At least 2 snapshots are needed; the agent caps this to
+	// an upper bound.
+	NumSnapshots int32 `protobuf:"varint,1,opt,name=num_snapshots,json=numSnapshots,proto3" json:"num_snapshots,omitempty"`
+	// interval_ms is the time between consecutive snapshots. If 0, the agent's
+	// default is used. The agent caps the total duration of the detection.
+	IntervalMs int64 `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	// max_stack_depth is like GetSnapshotIn.max_stack_depth.
+	MaxStackDepth int32 `protobuf:"varint,3,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+	// aggregation controls which goroutines are considered to have the same
+	// stack when counting goroutines per stack. NONE is not supported.
+	Aggregation GetSnapshotIn_Aggregation `protobuf:"varint,4,opt,name=aggregation,proto3,enum=agentrpc.GetSnapshotIn_Aggregation" json:"aggregation,omitempty"`
+	// max_results is the maximum number of stuck goroutines and of growing
+	// stacks returned. If 0, all of them are returned.
+	MaxResults int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+}
+
+func (x *DetectLeaksIn) Reset() {
+	*x = DetectLeaksIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectLeaksIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectLeaksIn) ProtoMessage() {}
+
+func (x *DetectLeaksIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectLeaksIn.ProtoReflect.Descriptor instead.
+func (*DetectLeaksIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DetectLeaksIn) GetNumSnapshots() int32 {
+	if x != nil {
+		return x.NumSnapshots
+	}
+	return 0
+}
+
+func (x *DetectLeaksIn) GetIntervalMs() int64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *DetectLeaksIn) GetMaxStackDepth() int32 {
+	if x != nil {
+		return x.MaxStackDepth
+	}
+	return 0
+}
+
+func (x *DetectLeaksIn) GetAggregation() GetSnapshotIn_Aggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return GetSnapshotIn_SIMILAR_ANY_VALUE
+}
+
+func (x *DetectLeaksIn) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+// StuckGoroutine is a goroutine that was blocked with the same stack in all
+// the snapshots.
+type StuckGoroutine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoroutineId int64 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	// state is the goroutine's state (e.g. "waiting").
+	State string        `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Stack []*StackFrame `protobuf:"bytes,3,rep,name=stack,proto3" json:"stack,omitempty"`
+}
+
+func (x *StuckGoroutine) Reset() {
+	*x = StuckGoroutine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StuckGoroutine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StuckGoroutine) ProtoMessage() {}
+
+func (x *StuckGoroutine) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StuckGoroutine.ProtoReflect.Descriptor instead.
+func (*StuckGoroutine) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StuckGoroutine) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *StuckGoroutine) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StuckGoroutine) GetStack() []*StackFrame {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+// GrowingStack is a stack whose number of goroutines grew across the
+// snapshots, without ever decreasing.
+type GrowingStack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stack []*StackFrame `protobuf:"bytes,1,rep,name=stack,proto3" json:"stack,omitempty"`
+	// counts are the numbers of goroutines with the stack in every snapshot.
+	Counts []int32 `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	// growth_per_second is the average number of goroutines added per second
+	// between the first and the last snapshot.
+	GrowthPerSecond float64 `protobuf:"fixed64,3,opt,name=growth_per_second,json=growthPerSecond,proto3" json:"growth_per_second,omitempty"`
+	// goroutine_ids are the IDs of the goroutines with the stack in the last
+	// snapshot.
+	GoroutineIds []int64 `protobuf:"varint,4,rep,packed,name=goroutine_ids,json=goroutineIds,proto3" json:"goroutine_ids,omitempty"`
+}
+
+func (x *GrowingStack) Reset() {
+	*x = GrowingStack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrowingStack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrowingStack) ProtoMessage() {}
+
+func (x *GrowingStack) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrowingStack.ProtoReflect.Descriptor instead.
+func (*GrowingStack) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GrowingStack) GetStack() []*StackFrame {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+func (x *GrowingStack) GetCounts() []int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *GrowingStack) GetGrowthPerSecond() float64 {
+	if x != nil {
+		return x.GrowthPerSecond
+	}
+	return 0
+}
+
+func (x *GrowingStack) GetGoroutineIds() []int64 {
+	if x != nil {
+		return x.GoroutineIds
+	}
+	return nil
+}
+
+type DetectLeaksOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stuck_goroutines are ordered by ID.
+	StuckGoroutines []*StuckGoroutine `protobuf:"bytes,1,rep,name=stuck_goroutines,json=stuckGoroutines,proto3" json:"stuck_goroutines,omitempty"`
+	// growing_stacks are ordered by decreasing growth rate.
+	GrowingStacks []*GrowingStack `protobuf:"bytes,2,rep,name=growing_stacks,json=growingStacks,proto3" json:"growing_stacks,omitempty"`
+	// truncated is set if there were more stuck goroutines or growing stacks
+	// than max_results.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// profile contains the stuck goroutines, as of the last snapshot. The
+	// samples are labeled with the goroutine IDs.
+	Profile *Profile `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	// snapshot_unix_nanos are the times when the snapshots were taken.
+	SnapshotUnixNanos []int64 `protobuf:"varint,5,rep,packed,name=snapshot_unix_nanos,json=snapshotUnixNanos,proto3" json:"snapshot_unix_nanos,omitempty"`
+	// num_goroutines are the numbers of goroutines in every snapshot.
+	NumGoroutines []int32 `protobuf:"varint,6,rep,packed,name=num_goroutines,json=numGoroutines,proto3" json:"num_goroutines,omitempty"`
+}
+
+func (x *DetectLeaksOut) Reset() {
+	*x = DetectLeaksOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectLeaksOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectLeaksOut) ProtoMessage() {}
+
+func (x *DetectLeaksOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectLeaksOut.ProtoReflect.Descriptor instead.
+func (*DetectLeaksOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DetectLeaksOut) GetStuckGoroutines() []*StuckGoroutine {
+	if x != nil {
+		return x.StuckGoroutines
+	}
+	return nil
+}
+
+func (x *DetectLeaksOut) GetGrowingStacks() []*GrowingStack {
+	if x != nil {
+		return x.GrowingStacks
+	}
+	return nil
+}
+
+func (x *DetectLeaksOut) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *DetectLeaksOut) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *DetectLeaksOut) GetSnapshotUnixNanos() []int64 {
+	if x != nil {
+		return x.SnapshotUnixNanos
+	}
+	return nil
+}
+
+func (x *DetectLeaksOut) GetNumGoroutines() []int32 {
+	if x != nil {
+		return x.NumGoroutines
+	}
+	return nil
+}
+
+type GetMutexContentionIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_stack_depth is like GetSnapshotIn.max_stack_depth. It also limits the
+	// frames searched for the mutexes' holders.
+	MaxStackDepth int32 `protobuf:"varint,1,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+	// min_waiters is the minimum number of waiters of the reported mutexes. If
+	// 0, all the mutexes with waiters are reported.
+	MinWaiters int32 `protobuf:"varint,2,opt,name=min_waiters,json=minWaiters,proto3" json:"min_waiters,omitempty"`
+}
+
+func (x *GetMutexContentionIn) Reset() {
+	*x = GetMutexContentionIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMutexContentionIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutexContentionIn) ProtoMessage() {}
+
+func (x *GetMutexContentionIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutexContentionIn.ProtoReflect.Descriptor instead.
+func (*GetMutexContentionIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetMutexContentionIn) GetMaxStackDepth() int32 {
+	if x != nil {
+		return x.MaxStackDepth
+	}
+	return 0
+}
+
+func (x *GetMutexContentionIn) GetMinWaiters() int32 {
+	if x != nil {
+		return x.MinWaiters
+	}
+	return 0
+}
+
+// LockSite is a location from where goroutines are waiting to lock a mutex.
+type LockSite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location   *StackFrame `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	NumWaiters int32       `protobuf:"varint,2,opt,name=num_waiters,json=numWaiters,proto3" json:"num_waiters,omitempty"`
+}
+
+func (x *LockSite) Reset() {
+	*x = LockSite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockSite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockSite) ProtoMessage() {}
+
+func (x *LockSite) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockSite.ProtoReflect.Descriptor instead.
+func (*LockSite) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LockSite) GetLocation() *StackFrame {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *LockSite) GetNumWaiters() int32 {
+	if x != nil {
+		return x.NumWaiters
+	}
+	return 0
+}
+
+// FrameReference is a frame that references an object (e.g. a mutex or a
+// channel) through its arguments or local variables.
+type FrameReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoroutineId int64       `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	Frame       *StackFrame `protobuf:"bytes,2,opt,name=frame,proto3" json:"frame,omitempty"`
+	FrameIdx    int32       `protobuf:"varint,3,opt,name=frame_idx,json=frameIdx,proto3" json:"frame_idx,omitempty"`
+	// path is the expression, rooted at one of the frame's arguments or local
+	// variables, through which the frame references the object (e.g.
+	// "(*s).mu").
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *FrameReference) Reset() {
+	*x = FrameReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameReference) ProtoMessage() {}
+
+func (x *FrameReference) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameReference.ProtoReflect.Descriptor instead.
+func (*FrameReference) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FrameReference) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *FrameReference) GetFrame() *StackFrame {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+func (x *FrameReference) GetFrameIdx() int32 {
+	if x != nil {
+		return x.FrameIdx
+	}
+	return 0
+}
+
+func (x *FrameReference) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// MutexContention describes a sync.Mutex (or the writer lock of a
+// sync.RWMutex) that goroutines are waiting for.
+type MutexContention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the mutex.
+	Address uint64 `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	// waiter_ids are the IDs of the goroutines waiting to lock the mutex.
+	WaiterIds []int64 `protobuf:"varint,2,rep,packed,name=waiter_ids,json=waiterIds,proto3" json:"waiter_ids,omitempty"`
+	// lock_sites are the locations from where the waiters are locking the
+	// mutex, ordered by decreasing number of waiters.
+	LockSites []*LockSite `protobuf:"bytes,3,rep,name=lock_sites,json=lockSites,proto3" json:"lock_sites,omitempty"`
+	// locked, starving and runtime_waiters are decoded from the mutex's state.
+	// runtime_waiters is the number of waiters as counted by the mutex itself;
+	// it can be larger than the number of waiter_ids, since goroutines that
+	// were woken up but haven't run yet are not recognized as waiters.
+	Locked         bool  `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`
+	Starving       bool  `protobuf:"varint,5,opt,name=starving,proto3" json:"starving,omitempty"`
+	RuntimeWaiters int32 `protobuf:"varint,6,opt,name=runtime_waiters,json=runtimeWaiters,proto3" json:"runtime_waiters,omitempty"`
+	// holder_candidates are the frames of other goroutines that reference the
+	// mutex. The runtime does not record which goroutine holds a mutex; it is
+	// usually one of the goroutines with a frame of a function that locked the
+	// mutex through its receiver (e.g. a method locking s.mu).
+	HolderCandidates []*FrameReference `protobuf:"bytes,7,rep,name=holder_candidates,json=holderCandidates,proto3" json:"holder_candidates,omitempty"`
+	// probable_holder_id is set if exactly one goroutine, other than the
+	// waiters, references the mutex. 0 otherwise.
+	ProbableHolderId int64 `protobuf:"varint,8,opt,name=probable_holder_id,json=probableHolderId,proto3" json:"probable_holder_id,omitempty"`
+}
+
+func (x *MutexContention) Reset() {
+	*x = MutexContention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutexContention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutexContention) ProtoMessage() {}
+
+func (x *MutexContention) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutexContention.ProtoReflect.Descriptor instead.
+func (*MutexContention) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MutexContention) GetAddress() uint64 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *MutexContention) GetWaiterIds() []int64 {
+	if x != nil {
+		return x.WaiterIds
+	}
+	return nil
+}
+
+func (x *MutexContention) GetLockSites() []*LockSite {
+	if x != nil {
+		return x.LockSites
+	}
+	return nil
+}
+
+func (x *MutexContention) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *MutexContention) GetStarving() bool {
+	if x != nil {
+		return x.Starving
+	}
+	return false
+}
+
+func (x *MutexContention) GetRuntimeWaiters() int32 {
+	if x != nil {
+		return x.RuntimeWaiters
+	}
+	return 0
+}
+
+func (x *MutexContention) GetHolderCandidates() []*FrameReference {
+	if x != nil {
+		return x.HolderCandidates
+	}
+	return nil
+}
+
+func (x *MutexContention) GetProbableHolderId() int64 {
+	if x != nil {
+		return x.ProbableHolderId
+	}
+	return 0
+}
+
+type GetMutexContentionOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// mutexes are ordered by decreasing number of waiters.
+	Mutexes []*MutexContention `protobuf:"bytes,1,rep,name=mutexes,proto3" json:"mutexes,omitempty"`
+	// num_unresolved_waiters is the number of goroutines waiting to lock a
+	// mutex whose address could not be determined.
+	NumUnresolvedWaiters int32 `protobuf:"varint,2,opt,name=num_unresolved_waiters,json=numUnresolvedWaiters,proto3" json:"num_unresolved_waiters,omitempty"`
+}
+
+func (x *GetMutexContentionOut) Reset() {
+	*x = GetMutexContentionOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMutexContentionOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutexContentionOut) ProtoMessage() {}
+
+func (x *GetMutexContentionOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutexContentionOut.ProtoReflect.Descriptor instead.
+func (*GetMutexContentionOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetMutexContentionOut) GetMutexes() []*MutexContention {
+	if x != nil {
+		return x.Mutexes
+	}
+	return nil
+}
+
+func (x *GetMutexContentionOut) GetNumUnresolvedWaiters() int32 {
+	if x != nil {
+		return x.NumUnresolvedWaiters
+	}
+	return 0
+}
+
+type GetChannelGraphIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_stack_depth is like GetSnapshotIn.max_stack_depth. It also limits the
+	// frames searched for references to the channels.
+	MaxStackDepth int32 `protobuf:"varint,1,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+	// render_dot requests a Graphviz DOT rendering of the graph.
+	RenderDot bool `protobuf:"varint,2,opt,name=render_dot,json=renderDot,proto3" json:"render_dot,omitempty"`
+	// render_json requests a JSON rendering of the graph.
+	RenderJson bool `protobuf:"varint,3,opt,name=render_json,json=renderJson,proto3" json:"render_json,omitempty"`
+}
+
+func (x *GetChannelGraphIn) Reset() {
+	*x = GetChannelGraphIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelGraphIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelGraphIn) ProtoMessage() {}
+
+func (x *GetChannelGraphIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelGraphIn.ProtoReflect.Descriptor instead.
+func (*GetChannelGraphIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetChannelGraphIn) GetMaxStackDepth() int32 {
+	if x != nil {
+		return x.MaxStackDepth
+	}
+	return 0
+}
+
+func (x *GetChannelGraphIn) GetRenderDot() bool {
+	if x != nil {
+		return x.RenderDot
+	}
+	return false
+}
+
+func (x *GetChannelGraphIn) GetRenderJson() bool {
+	if x != nil {
+		return x.RenderJson
+	}
+	return false
+}
+
+// ChannelWait describes a goroutine blocked on channel operations.
+type ChannelWait struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoroutineId int64                 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	Operation   ChannelWait_Operation `protobuf:"varint,2,opt,name=operation,proto3,enum=agentrpc.ChannelWait_Operation" json:"operation,omitempty"`
+	// channels are the addresses of the channels that the goroutine waits on.
+	// For selects, there is one channel per case.
+	Channels []uint64 `protobuf:"varint,3,rep,packed,name=channels,proto3" json:"channels,omitempty"`
+	// location is the frame closest to the leaf outside of the runtime; it is
+	// usually the frame of the channel operation.
+	Location *StackFrame `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// nil_channel is set if the goroutine is blocked forever on a nil channel.
+	NilChannel bool `protobuf:"varint,5,opt,name=nil_channel,json=nilChannel,proto3" json:"nil_channel,omitempty"`
+	// probable_deadlock is set if none of the goroutines that could unblock this
+	// one (the ones referencing its channels) can make progress either; see
+	// GetChannelGraphOut.deadlocked_goroutine_ids.
+	ProbableDeadlock bool `protobuf:"varint,6,opt,name=probable_deadlock,json=probableDeadlock,proto3" json:"probable_deadlock,omitempty"`
+}
+
+func (x *ChannelWait) Reset() {
+	*x = ChannelWait{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelWait) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelWait) ProtoMessage() {}
+
+func (x *ChannelWait) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelWait.ProtoReflect.Descriptor instead.
+func (*ChannelWait) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ChannelWait) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *ChannelWait) GetOperation() ChannelWait_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return ChannelWait_RECEIVE
+}
+
+func (x *ChannelWait) GetChannels() []uint64 {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *ChannelWait) GetLocation() *StackFrame {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ChannelWait) GetNilChannel() bool {
+	if x != nil {
+		return x.NilChannel
+	}
+	return false
+}
+
+func (x *ChannelWait) GetProbableDeadlock() bool {
+	if x != nil {
+		return x.ProbableDeadlock
+	}
+	return false
+}
+
+// ChannelInfo describes a channel that goroutines are blocked on.
+type ChannelInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address uint64 `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	// len and capacity are the number of elements in the channel's buffer and
+	// the buffer's size.
+	Len      int64 `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`
+	Capacity int64 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Closed   bool  `protobuf:"varint,4,opt,name=closed,proto3" json:"closed,omitempty"`
+	// waiter_ids are the IDs of the goroutines blocked on the channel.
+	WaiterIds []int64 `protobuf:"varint,5,rep,packed,name=waiter_ids,json=waiterIds,proto3" json:"waiter_ids,omitempty"`
+	// references are the frames of goroutines other than the waiters that
+	// reference the channel. These goroutines could unblock the waiters.
+	References []*FrameReference `protobuf:"bytes,6,rep,name=references,proto3" json:"references,omitempty"`
+	// no_counterpart is set if no goroutine other than the waiters references
+	// the channel. The channel might still be reachable from global variables
+	// or heap objects that are not referenced from the goroutines' frames, so
+	// this is a probable deadlock rather than a certain one.
+	NoCounterpart bool `protobuf:"varint,7,opt,name=no_counterpart,json=noCounterpart,proto3" json:"no_counterpart,omitempty"`
+}
+
+func (x *ChannelInfo) Reset() {
+	*x = ChannelInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelInfo) ProtoMessage() {}
+
+func (x *ChannelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelInfo.ProtoReflect.Descriptor instead.
+func (*ChannelInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ChannelInfo) GetAddress() uint64 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *ChannelInfo) GetLen() int64 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+func (x *ChannelInfo) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ChannelInfo) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *ChannelInfo) GetWaiterIds() []int64 {
+	if x != nil {
+		return x.WaiterIds
+	}
+	return nil
+}
+
+func (x *ChannelInfo) GetReferences() []*FrameReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *ChannelInfo) GetNoCounterpart() bool {
+	if x != nil {
+		return x.NoCounterpart
+	}
+	return false
+}
+
+type GetChannelGraphOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// waits are ordered by goroutine ID.
+	Waits []*ChannelWait `protobuf:"bytes,1,rep,name=waits,proto3" json:"waits,omitempty"`
+	// channels are ordered by decreasing number of waiters.
+	Channels []*ChannelInfo `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	// deadlocked_goroutine_ids are the goroutines that are probably deadlocked:
+	// the largest set of goroutines blocked on channels such that every
+	// goroutine that could unblock one of them is in the set too. This covers
+	// both cycles in the wait-for graph and channels without counterparts.
+	DeadlockedGoroutineIds []int64 `protobuf:"varint,3,rep,packed,name=deadlocked_goroutine_ids,json=deadlockedGoroutineIds,proto3" json:"deadlocked_goroutine_ids,omitempty"`
+	// dot is the Graphviz DOT rendering of the graph, if requested.
+	Dot string `protobuf:"bytes,4,opt,name=dot,proto3" json:"dot,omitempty"`
+	// json is the JSON rendering of the graph (waits, channels and
+	// deadlocked_goroutine_ids), if requested.
+	Json string `protobuf:"bytes,5,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *GetChannelGraphOut) Reset() {
+	*x = GetChannelGraphOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelGraphOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelGraphOut) ProtoMessage() {}
+
+func (x *GetChannelGraphOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelGraphOut.ProtoReflect.Descriptor instead.
+func (*GetChannelGraphOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetChannelGraphOut) GetWaits() []*ChannelWait {
+	if x != nil {
+		return x.Waits
+	}
+	return nil
+}
+
+func (x *GetChannelGraphOut) GetChannels() []*ChannelInfo {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *GetChannelGraphOut) GetDeadlockedGoroutineIds() []int64 {
+	if x != nil {
+		return x.DeadlockedGoroutineIds
+	}
+	return nil
+}
+
+func (x *GetChannelGraphOut) GetDot() string {
+	if x != nil {
+		return x.Dot
+	}
+	return ""
+}
+
+func (x *GetChannelGraphOut) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type GetSyncWaitersIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_stack_depth is like GetSnapshotIn.max_stack_depth.
+	MaxStackDepth int32 `protobuf:"varint,1,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+}
+
+func (x *GetSyncWaitersIn) Reset() {
+	*x = GetSyncWaitersIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncWaitersIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncWaitersIn) ProtoMessage() {}
+
+func (x *GetSyncWaitersIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncWaitersIn.ProtoReflect.Descriptor instead.
+func (*GetSyncWaitersIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetSyncWaitersIn) GetMaxStackDepth() int32 {
+	if x != nil {
+		return x.MaxStackDepth
+	}
+	return 0
+}
+
+// SyncPrimitive describes a synchronization primitive that goroutines are
+// blocked on. Mutexes are covered by GetMutexContention instead.
+type SyncPrimitive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    SyncPrimitive_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=agentrpc.SyncPrimitive_Kind" json:"kind,omitempty"`
+	Address uint64             `protobuf:"varint,2,opt,name=address,proto3" json:"address,omitempty"`
+	// counter is the primitive's counter, as read from the waiters' frames:
+	// - for a WAIT_GROUP, the number of Done calls that the waiters are waiting
+	//   for;
+	// - for a COND, the number of goroutines waiting to be notified;
+	// - for a RW_MUTEX, the number of readers holding the lock or waiting for it
+	//   (excluding the writer_pending bias);
+	// - for a SEMAPHORE, the semaphore's value.
+	Counter int64 `protobuf:"varint,3,opt,name=counter,proto3" json:"counter,omitempty"`
+	// waiter_ids are the IDs of the goroutines blocked on the primitive. For a
+	// RW_MUTEX, these are the readers.
+	WaiterIds []int64 `protobuf:"varint,4,rep,packed,name=waiter_ids,json=waiterIds,proto3" json:"waiter_ids,omitempty"`
+	// writer_ids are the IDs of the goroutines blocked in RWMutex.Lock.
+	WriterIds []int64 `protobuf:"varint,5,rep,packed,name=writer_ids,json=writerIds,proto3" json:"writer_ids,omitempty"`
+	// writer_pending is set for a RW_MUTEX if a writer holds the lock or is
+	// waiting for the readers to release it.
+	WriterPending bool `protobuf:"varint,6,opt,name=writer_pending,json=writerPending,proto3" json:"writer_pending,omitempty"`
+	// departing_readers is, for a RW_MUTEX with a pending writer, the number of
+	// readers that the writer is waiting for.
+	DepartingReaders int64 `protobuf:"varint,7,opt,name=departing_readers,json=departingReaders,proto3" json:"departing_readers,omitempty"`
+	// runtime_waiters is, for a WAIT_GROUP, the number of waiters according to
+	// the WaitGroup's state.
+	RuntimeWaiters int64 `protobuf:"varint,8,opt,name=runtime_waiters,json=runtimeWaiters,proto3" json:"runtime_waiters,omitempty"`
+}
+
+func (x *SyncPrimitive) Reset() {
+	*x = SyncPrimitive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncPrimitive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPrimitive) ProtoMessage() {}
+
+func (x *SyncPrimitive) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPrimitive.ProtoReflect.Descriptor instead.
+func (*SyncPrimitive) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SyncPrimitive) GetKind() SyncPrimitive_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return SyncPrimitive_WAIT_GROUP
+}
+
+func (x *SyncPrimitive) GetAddress() uint64 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *SyncPrimitive) GetCounter() int64 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+func (x *SyncPrimitive) GetWaiterIds() []int64 {
+	if x != nil {
+		return x.WaiterIds
+	}
+	return nil
+}
+
+func (x *SyncPrimitive) GetWriterIds() []int64 {
+	if x != nil {
+		return x.WriterIds
+	}
+	return nil
+}
+
+func (x *SyncPrimitive) GetWriterPending() bool {
+	if x != nil {
+		return x.WriterPending
+	}
+	return false
+}
+
+func (x *SyncPrimitive) GetDepartingReaders() int64 {
+	if x != nil {
+		return x.DepartingReaders
+	}
+	return 0
+}
+
+func (x *SyncPrimitive) GetRuntimeWaiters() int64 {
+	if x != nil {
+		return x.RuntimeWaiters
+	}
+	return 0
+}
+
+type GetSyncWaitersOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// primitives are ordered by decreasing number of waiters.
+	Primitives []*SyncPrimitive `protobuf:"bytes,1,rep,name=primitives,proto3" json:"primitives,omitempty"`
+	// num_unresolved_waiters is the number of goroutines blocked on a primitive
+	// whose address could not be read.
+	NumUnresolvedWaiters int32 `protobuf:"varint,2,opt,name=num_unresolved_waiters,json=numUnresolvedWaiters,proto3" json:"num_unresolved_waiters,omitempty"`
+}
+
+func (x *GetSyncWaitersOut) Reset() {
+	*x = GetSyncWaitersOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncWaitersOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncWaitersOut) ProtoMessage() {}
+
+func (x *GetSyncWaitersOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncWaitersOut.ProtoReflect.Descriptor instead.
+func (*GetSyncWaitersOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetSyncWaitersOut) GetPrimitives() []*SyncPrimitive {
+	if x != nil {
+		return x.Primitives
+	}
+	return nil
+}
+
+func (x *GetSyncWaitersOut) GetNumUnresolvedWaiters() int32 {
+	if x != nil {
+		return x.NumUnresolvedWaiters
+	}
+	return 0
+}
+
+type GetNetConnectionsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_stack_depth is like GetSnapshotIn.max_stack_depth.
+	MaxStackDepth int32 `protobuf:"varint,1,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+}
+
+func (x *GetNetConnectionsIn) Reset() {
+	*x = GetNetConnectionsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNetConnectionsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetConnectionsIn) ProtoMessage() {}
+
+func (x *GetNetConnectionsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetConnectionsIn.ProtoReflect.Descriptor instead.
+func (*GetNetConnectionsIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetNetConnectionsIn) GetMaxStackDepth() int32 {
+	if x != nil {
+		return x.MaxStackDepth
+	}
+	return 0
+}
+
+// NetConnection is a file descriptor that a goroutine is blocked on in the
+// network poller, and the socket it refers to.
+type NetConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoroutineId int64 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	// operation is the internal/poll.FD method that the goroutine is blocked in
+	// (e.g. "Read", "Accept").
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// location is the frame closest to the leaf outside of the runtime, os,
+	// syscall, net and internal/poll packages.
+	Location *StackFrame `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Fd       int64       `protobuf:"varint,4,opt,name=fd,proto3" json:"fd,omitempty"`
+	// inode is the inode of the socket, if the fd is a socket.
+	Inode uint64 `protobuf:"varint,5,opt,name=inode,proto3" json:"inode,omitempty"`
+	// protocol is one of "tcp", "tcp6", "udp" and "udp6". It is empty if the fd
+	// is not an internet socket (e.g. a Unix socket or a pipe).
+	Protocol string `protobuf:"bytes,6,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// local_address and remote_address are formatted as host:port.
+	LocalAddress  string `protobuf:"bytes,7,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	RemoteAddress string `protobuf:"bytes,8,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	// state is the socket's state, as reported by the kernel (e.g.
+	// "ESTABLISHED", "LISTEN").
+	State string `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty"`
+	// error is set if the fd or the socket could not be resolved.
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *NetConnection) Reset() {
+	*x = NetConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetConnection) ProtoMessage() {}
+
+func (x *NetConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetConnection.ProtoReflect.Descriptor instead.
+func (*NetConnection) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *NetConnection) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *NetConnection) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *NetConnection) GetLocation() *StackFrame {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *NetConnection) GetFd() int64 {
+	if x != nil {
+		return x.Fd
+	}
+	return 0
+}
+
+func (x *NetConnection) GetInode() uint64 {
+	if x != nil {
+		return x.Inode
+	}
+	return 0
+}
+
+func (x *NetConnection) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *NetConnection) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return ""
+}
+
+func (x *NetConnection) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *NetConnection) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NetConnection) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetNetConnectionsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// connections are ordered by goroutine ID.
+	Connections []*NetConnection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *GetNetConnectionsOut) Reset() {
+	*x = GetNetConnectionsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNetConnectionsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetConnectionsOut) ProtoMessage() {}
+
+func (x *GetNetConnectionsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetConnectionsOut.ProtoReflect.Descriptor instead.
+func (*GetNetConnectionsOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetNetConnectionsOut) GetConnections() []*NetConnection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+type GetRuntimeInfoIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRuntimeInfoIn) Reset() {
+	*x = GetRuntimeInfoIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRuntimeInfoIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuntimeInfoIn) ProtoMessage() {}
+
+func (x *GetRuntimeInfoIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuntimeInfoIn.ProtoReflect.Descriptor instead.
+func (*GetRuntimeInfoIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{55}
+}
+
+// SchedInfo is the state of the scheduler, as printed by
+// GODEBUG=schedtrace=X.
+type SchedInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gomaxprocs int32 `protobuf:"varint,1,opt,name=gomaxprocs,proto3" json:"gomaxprocs,omitempty"`
+	IdlePs     int32 `protobuf:"varint,2,opt,name=idle_ps,json=idlePs,proto3" json:"idle_ps,omitempty"`
+	// num_ms is the number of threads.
+	NumMs      int32 `protobuf:"varint,3,opt,name=num_ms,json=numMs,proto3" json:"num_ms,omitempty"`
+	IdleMs     int32 `protobuf:"varint,4,opt,name=idle_ms,json=idleMs,proto3" json:"idle_ms,omitempty"`
+	SpinningMs int32 `protobuf:"varint,5,opt,name=spinning_ms,json=spinningMs,proto3" json:"spinning_ms,omitempty"`
+	// global_runqueue_size is the number of goroutines in the global run queue.
+	GlobalRunqueueSize int32 `protobuf:"varint,6,opt,name=global_runqueue_size,json=globalRunqueueSize,proto3" json:"global_runqueue_size,omitempty"`
+}
+
+func (x *SchedInfo) Reset() {
+	*x = SchedInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedInfo) ProtoMessage() {}
+
+func (x *SchedInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedInfo.ProtoReflect.Descriptor instead.
+func (*SchedInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SchedInfo) GetGomaxprocs() int32 {
+	if x != nil {
+		return x.Gomaxprocs
+	}
+	return 0
+}
+
+func (x *SchedInfo) GetIdlePs() int32 {
+	if x != nil {
+		return x.IdlePs
+	}
+	return 0
+}
+
+func (x *SchedInfo) GetNumMs() int32 {
+	if x != nil {
+		return x.NumMs
+	}
+	return 0
+}
+
+func (x *SchedInfo) GetIdleMs() int32 {
+	if x != nil {
+		return x.IdleMs
+	}
+	return 0
+}
+
+func (x *SchedInfo) GetSpinningMs() int32 {
+	if x != nil {
+		return x.SpinningMs
+	}
+	return 0
+}
+
+func (x *SchedInfo) GetGlobalRunqueueSize() int32 {
+	if x != nil {
+		return x.GlobalRunqueueSize
+	}
+	return 0
+}
+
+// ProcessorInfo is the state of one of the scheduler's Ps.
+type ProcessorInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status is one of "idle", "running", "syscall", "gcstop" and "dead".
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// runqueue_size is the number of goroutines in the P's local run queue,
+	// including the one in runnext.
+	RunqueueSize int32  `protobuf:"varint,3,opt,name=runqueue_size,json=runqueueSize,proto3" json:"runqueue_size,omitempty"`
+	Schedtick    uint32 `protobuf:"varint,4,opt,name=schedtick,proto3" json:"schedtick,omitempty"`
+	Syscalltick  uint32 `protobuf:"varint,5,opt,name=syscalltick,proto3" json:"syscalltick,omitempty"`
+	// has_m is set if the P is associated with a thread.
+	HasM bool `protobuf:"varint,6,opt,name=has_m,json=hasM,proto3" json:"has_m,omitempty"`
+}
+
+func (x *ProcessorInfo) Reset() {
+	*x = ProcessorInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorInfo) ProtoMessage() {}
+
+func (x *ProcessorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorInfo.ProtoReflect.Descriptor instead.
+func (*ProcessorInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ProcessorInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProcessorInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProcessorInfo) GetRunqueueSize() int32 {
+	if x != nil {
+		return x.RunqueueSize
+	}
+	return 0
+}
+
+func (x *ProcessorInfo) GetSchedtick() uint32 {
+	if x != nil {
+		return x.Schedtick
+	}
+	return 0
+}
+
+func (x *ProcessorInfo) GetSyscalltick() uint32 {
+	if x != nil {
+		return x.Syscalltick
+	}
+	return 0
+}
+
+func (x *ProcessorInfo) GetHasM() bool {
+	if x != nil {
+		return x.HasM
+	}
+	return false
+}
+
+type GCInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// phase is one of "off", "mark" and "mark termination". It is empty if
+	// the phase could not be read, in which case runtime.gcphase is listed in
+	// GetRuntimeInfoOut.unavailable_fields.
+	Phase string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// cycles is the number of the current or last GC cycle.
+	Cycles uint32 `protobuf:"varint,2,opt,name=cycles,proto3" json:"cycles,omitempty"`
+	// num_gc is the number of completed GC cycles.
+	NumGc           uint32 `protobuf:"varint,3,opt,name=num_gc,json=numGc,proto3" json:"num_gc,omitempty"`
+	GcPercent       int32  `protobuf:"varint,4,opt,name=gc_percent,json=gcPercent,proto3" json:"gc_percent,omitempty"`
+	LastGcUnixNanos int64  `protobuf:"varint,5,opt,name=last_gc_unix_nanos,json=lastGcUnixNanos,proto3" json:"last_gc_unix_nanos,omitempty"`
+	PauseTotalNanos uint64 `protobuf:"varint,6,opt,name=pause_total_nanos,json=pauseTotalNanos,proto3" json:"pause_total_nanos,omitempty"`
+	// heap_live_bytes is the number of bytes considered live by the GC: the
+	// bytes marked by the last cycle plus the ones allocated since.
+	HeapLiveBytes uint64 `protobuf:"varint,7,opt,name=heap_live_bytes,json=heapLiveBytes,proto3" json:"heap_live_bytes,omitempty"`
+	// heap_marked_bytes is the number of bytes marked by the last cycle.
+	HeapMarkedBytes uint64 `protobuf:"varint,8,opt,name=heap_marked_bytes,json=heapMarkedBytes,proto3" json:"heap_marked_bytes,omitempty"`
+}
+
+func (x *GCInfo) Reset() {
+	*x = GCInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GCInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCInfo) ProtoMessage() {}
+
+func (x *GCInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCInfo.ProtoReflect.Descriptor instead.
+func (*GCInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GCInfo) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *GCInfo) GetCycles() uint32 {
+	if x != nil {
+		return x.Cycles
+	}
+	return 0
+}
+
+func (x *GCInfo) GetNumGc() uint32 {
+	if x != nil {
+		return x.NumGc
+	}
+	return 0
+}
+
+func (x *GCInfo) GetGcPercent() int32 {
+	if x != nil {
+		return x.GcPercent
+	}
+	return 0
+}
+
+func (x *GCInfo) GetLastGcUnixNanos() int64 {
+	if x != nil {
+		return x.LastGcUnixNanos
+	}
+	return 0
+}
+
+func (x *GCInfo) GetPauseTotalNanos() uint64 {
+	if x != nil {
+		return x.PauseTotalNanos
+	}
+	return 0
+}
+
+func (x *GCInfo) GetHeapLiveBytes() uint64 {
+	if x != nil {
+		return x.HeapLiveBytes
+	}
+	return 0
+}
+
+func (x *GCInfo) GetHeapMarkedBytes() uint64 {
+	if x != nil {
+		return x.HeapMarkedBytes
+	}
+	return 0
+}
+
+// MemStats are some of the runtime's memory statistics; see
+// runtime.MemStats.
+type MemStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeapInUseBytes    uint64 `protobuf:"varint,1,opt,name=heap_in_use_bytes,json=heapInUseBytes,proto3" json:"heap_in_use_bytes,omitempty"`
+	HeapFreeBytes     uint64 `protobuf:"varint,2,opt,name=heap_free_bytes,json=heapFreeBytes,proto3" json:"heap_free_bytes,omitempty"`
+	HeapReleasedBytes uint64 `protobuf:"varint,3,opt,name=heap_released_bytes,json=heapReleasedBytes,proto3" json:"heap_released_bytes,omitempty"`
+	StacksSysBytes    uint64 `protobuf:"varint,4,opt,name=stacks_sys_bytes,json=stacksSysBytes,proto3" json:"stacks_sys_bytes,omitempty"`
+	TotalAllocBytes   uint64 `protobuf:"varint,5,opt,name=total_alloc_bytes,json=totalAllocBytes,proto3" json:"total_alloc_bytes,omitempty"`
+	TotalFreeBytes    uint64 `protobuf:"varint,6,opt,name=total_free_bytes,json=totalFreeBytes,proto3" json:"total_free_bytes,omitempty"`
+}
+
+func (x *MemStats) Reset() {
+	*x = MemStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemStats) ProtoMessage() {}
+
+func (x *MemStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemStats.ProtoReflect.Descriptor instead.
+func (*MemStats) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MemStats) GetHeapInUseBytes() uint64 {
+	if x != nil {
+		return x.HeapInUseBytes
+	}
+	return 0
+}
+
+func (x *MemStats) GetHeapFreeBytes() uint64 {
+	if x != nil {
+		return x.HeapFreeBytes
+	}
+	return 0
+}
+
+func (x *MemStats) GetHeapReleasedBytes() uint64 {
+	if x != nil {
+		return x.HeapReleasedBytes
+	}
+	return 0
+}
+
+func (x *MemStats) GetStacksSysBytes() uint64 {
+	if x != nil {
+		return x.StacksSysBytes
+	}
+	return 0
+}
+
+func (x *MemStats) GetTotalAllocBytes() uint64 {
+	if x != nil {
+		return x.TotalAllocBytes
+	}
+	return 0
+}
+
+func (x *MemStats) GetTotalFreeBytes() uint64 {
+	if x != nil {
+		return x.TotalFreeBytes
+	}
+	return 0
+}
+
+type GetRuntimeInfoOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// go_version is the version of Go that the target was built with.
+	GoVersion  string           `protobuf:"bytes,1,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	Sched      *SchedInfo       `protobuf:"bytes,2,opt,name=sched,proto3" json:"sched,omitempty"`
+	Processors []*ProcessorInfo `protobuf:"bytes,3,rep,name=processors,proto3" json:"processors,omitempty"`
+	Gc         *GCInfo          `protobuf:"bytes,4,opt,name=gc,proto3" json:"gc,omitempty"`
+	MemStats   *MemStats        `protobuf:"bytes,5,opt,name=mem_stats,json=memStats,proto3" json:"mem_stats,omitempty"`
+	// unavailable_fields are the fields above that could not be read, because
+	// the target's runtime doesn't have them. The runtime's internal structures
+	// change between Go versions. The fields are named after the runtime
+	// expressions that were tried (e.g. "runtime.memstats.heapFree").
+	UnavailableFields []string `protobuf:"bytes,6,rep,name=unavailable_fields,json=unavailableFields,proto3" json:"unavailable_fields,omitempty"`
+}
+
+func (x *GetRuntimeInfoOut) Reset() {
+	*x = GetRuntimeInfoOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRuntimeInfoOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuntimeInfoOut) ProtoMessage() {}
+
+func (x *GetRuntimeInfoOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuntimeInfoOut.ProtoReflect.Descriptor instead.
+func (*GetRuntimeInfoOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetRuntimeInfoOut) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *GetRuntimeInfoOut) GetSched() *SchedInfo {
+	if x != nil {
+		return x.Sched
+	}
+	return nil
+}
+
+func (x *GetRuntimeInfoOut) GetProcessors() []*ProcessorInfo {
+	if x != nil {
+		return x.Processors
+	}
+	return nil
+}
+
+func (x *GetRuntimeInfoOut) GetGc() *GCInfo {
+	if x != nil {
+		return x.Gc
+	}
+	return nil
+}
+
+func (x *GetRuntimeInfoOut) GetMemStats() *MemStats {
+	if x != nil {
+		return x.MemStats
+	}
+	return nil
+}
+
+func (x *GetRuntimeInfoOut) GetUnavailableFields() []string {
+	if x != nil {
+		return x.UnavailableFields
+	}
+	return nil
+}
+
+type GetGoroutineIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoroutineId int64 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	// max_stack_depth is the maximum number of frames returned. If 0, the
+	// agent's default is used. The agent caps this to an upper bound.
+	MaxStackDepth int32 `protobuf:"varint,2,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+	// load_config, if set, overrides the agent's default load configuration for
+	// the frames' arguments and local variables.
+	LoadConfig *LoadConfig `protobuf:"bytes,3,opt,name=load_config,json=loadConfig,proto3" json:"load_config,omitempty"`
+}
+
+func (x *GetGoroutineIn) Reset() {
+	*x = GetGoroutineIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGoroutineIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoroutineIn) ProtoMessage() {}
+
+func (x *GetGoroutineIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoroutineIn.ProtoReflect.Descriptor instead.
+func (*GetGoroutineIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetGoroutineIn) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *GetGoroutineIn) GetMaxStackDepth() int32 {
+	if x != nil {
+		return x.MaxStackDepth
+	}
+	return 0
+}
+
+func (x *GetGoroutineIn) GetLoadConfig() *LoadConfig {
+	if x != nil {
+		return x.LoadConfig
+	}
+	return nil
+}
+
+// GoroutineFrame is a frame of a goroutine's stack, together with the frame's
+// variables.
+type GoroutineFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frame *StackFrame `protobuf:"bytes,1,opt,name=frame,proto3" json:"frame,omitempty"`
+	// pc is the frame's program counter.
+	Pc        uint64   `protobuf:"varint,2,opt,name=pc,proto3" json:"pc,omitempty"`
+	Arguments []*Value `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	Locals    []*Value `protobuf:"bytes,4,rep,name=locals,proto3" json:"locals,omitempty"`
+	// error is set if the frame could not be fully read.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GoroutineFrame) Reset() {
+	*x = GoroutineFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoroutineFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoroutineFrame) ProtoMessage() {}
+
+func (x *GoroutineFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoroutineFrame.ProtoReflect.Descriptor instead.
+func (*GoroutineFrame) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GoroutineFrame) GetFrame() *StackFrame {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+func (x *GoroutineFrame) GetPc() uint64 {
+	if x != nil {
+		return x.Pc
+	}
+	return 0
+}
+
+func (x *GoroutineFrame) GetArguments() []*Value {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *GoroutineFrame) GetLocals() []*Value {
+	if x != nil {
+		return x.Locals
+	}
+	return nil
+}
+
+func (x *GoroutineFrame) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetGoroutineOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoroutineId int64 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	// frames are ordered from the leaf towards the root.
+	Frames []*GoroutineFrame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
+	// truncated is set if the stack was deeper than max_stack_depth.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *GetGoroutineOut) Reset() {
+	*x = GetGoroutineOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGoroutineOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoroutineOut) ProtoMessage() {}
+
+func (x *GetGoroutineOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoroutineOut.ProtoReflect.Descriptor instead.
+func (*GetGoroutineOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetGoroutineOut) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *GetGoroutineOut) GetFrames() []*GoroutineFrame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *GetGoroutineOut) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type GoroutineMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoroutineId int64 `protobuf:"varint,1,opt,name=goroutine_id,json=goroutineId,proto3" json:"goroutine_id,omitempty"`
+	// stack is the goroutine's stack, starting with the leaf function.
+	Stack []*StackFrame `protobuf:"bytes,2,rep,name=stack,proto3" json:"stack,omitempty"`
+	// matches contains, for every frame where matching values were captured,
+	// these values.
+	Matches []*FrameData `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *GoroutineMatch) Reset() {
+	*x = GoroutineMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoroutineMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoroutineMatch) ProtoMessage() {}
+
+func (x *GoroutineMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoroutineMatch.ProtoReflect.Descriptor instead.
+func (*GoroutineMatch) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GoroutineMatch) GetGoroutineId() int64 {
+	if x != nil {
+		return x.GoroutineId
+	}
+	return 0
+}
+
+func (x *GoroutineMatch) GetStack() []*StackFrame {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+func (x *GoroutineMatch) GetMatches() []*FrameData {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type FindGoroutinesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// goroutines are the goroutines for which at least one of the captured
+	// values matched, ordered by goroutine ID.
+	Goroutines []*GoroutineMatch `protobuf:"bytes,1,rep,name=goroutines,proto3" json:"goroutines,omitempty"`
+	// truncated is set if more goroutines matched than max_results.
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// num_goroutines is the number of goroutines that were searched.
+	NumGoroutines int32 `protobuf:"varint,3,opt,name=num_goroutines,json=numGoroutines,proto3" json:"num_goroutines,omitempty"`
+}
+
+func (x *FindGoroutinesOut) Reset() {
+	*x = FindGoroutinesOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindGoroutinesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindGoroutinesOut) ProtoMessage() {}
+
+func (x *FindGoroutinesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindGoroutinesOut.ProtoReflect.Descriptor instead.
+func (*FindGoroutinesOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *FindGoroutinesOut) GetGoroutines() []*GoroutineMatch {
+	if x != nil {
+		return x.Goroutines
+	}
+	return nil
+}
+
+func (x *FindGoroutinesOut) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *FindGoroutinesOut) GetNumGoroutines() int32 {
+	if x != nil {
+		return x.NumGoroutines
+	}
+	return 0
+}
+
+// ScriptParam declares a parameter of a registered script.
+type ScriptParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the parameter's name. The script reads the parameter's value as
+	// params[name].
+	Name string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type ScriptParam_Type `protobuf:"varint,2,opt,name=type,proto3,enum=agentrpc.ScriptParam_Type" json:"type,omitempty"`
+	// required is set if ExecScript calls need to provide a value for the
+	// parameter. Optional parameters that are not provided are absent from
+	// params.
+	Required    bool   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ScriptParam) Reset() {
+	*x = ScriptParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScriptParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptParam) ProtoMessage() {}
+
+func (x *ScriptParam) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptParam.ProtoReflect.Descriptor instead.
+func (*ScriptParam) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ScriptParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScriptParam) GetType() ScriptParam_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ScriptParam_STRING
+}
+
+func (x *ScriptParam) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *ScriptParam) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// RegisteredScript is a named Starlark script registered through the
+// ScriptService.
+//
+// The script is run like the agent's own scripts: Delve calls its main()
+// function, which needs to return the script's result encoded as JSON (e.g.
+// `return json.encode(res)`). The arguments are available to the script as the
+// global dict `params`.
+type RegisteredScript struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name identifies the script in ExecScript calls.
+	Name   string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source string         `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Params []*ScriptParam `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	// result_schema is a JSON Schema document describing the script's result.
+	// The agent checks that it is valid JSON, but doesn't validate results
+	// against it; it is there for the benefit of clients.
+	ResultSchema string `protobuf:"bytes,4,opt,name=result_schema,json=resultSchema,proto3" json:"result_schema,omitempty"`
+	Description  string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// version identifies the script's source. Set by the agent.
+	Version string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RegisteredScript) Reset() {
+	*x = RegisteredScript{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisteredScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredScript) ProtoMessage() {}
+
+func (x *RegisteredScript) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredScript.ProtoReflect.Descriptor instead.
+func (*RegisteredScript) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RegisteredScript) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisteredScript) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RegisteredScript) GetParams() []*ScriptParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *RegisteredScript) GetResultSchema() string {
+	if x != nil {
+		return x.ResultSchema
+	}
+	return ""
+}
+
+func (x *RegisteredScript) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RegisteredScript) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type RegisterScriptIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// script is the script to register. A script previously registered with
+	// the same name is replaced.
+	Script *RegisteredScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+}
+
+func (x *RegisterScriptIn) Reset() {
+	*x = RegisterScriptIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterScriptIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterScriptIn) ProtoMessage() {}
+
+func (x *RegisterScriptIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterScriptIn.ProtoReflect.Descriptor instead.
+func (*RegisterScriptIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RegisterScriptIn) GetScript() *RegisteredScript {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+type RegisterScriptOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version is the version assigned to the registered script.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RegisterScriptOut) Reset() {
+	*x = RegisterScriptOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterScriptOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterScriptOut) ProtoMessage() {}
+
+func (x *RegisterScriptOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterScriptOut.ProtoReflect.Descriptor instead.
+func (*RegisterScriptOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RegisterScriptOut) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type UnregisterScriptIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UnregisterScriptIn) Reset() {
+	*x = UnregisterScriptIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnregisterScriptIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterScriptIn) ProtoMessage() {}
+
+func (x *UnregisterScriptIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterScriptIn.ProtoReflect.Descriptor instead.
+func (*UnregisterScriptIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UnregisterScriptIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnregisterScriptOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnregisterScriptOut) Reset() {
+	*x = UnregisterScriptOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnregisterScriptOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterScriptOut) ProtoMessage() {}
+
+func (x *UnregisterScriptOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterScriptOut.ProtoReflect.Descriptor instead.
+func (*UnregisterScriptOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{71}
+}
+
+type ListRegisteredScriptsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRegisteredScriptsIn) Reset() {
+	*x = ListRegisteredScriptsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRegisteredScriptsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegisteredScriptsIn) ProtoMessage() {}
+
+func (x *ListRegisteredScriptsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegisteredScriptsIn.ProtoReflect.Descriptor instead.
+func (*ListRegisteredScriptsIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{72}
+}
+
+type ListRegisteredScriptsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scripts []*RegisteredScript `protobuf:"bytes,1,rep,name=scripts,proto3" json:"scripts,omitempty"`
+}
+
+func (x *ListRegisteredScriptsOut) Reset() {
+	*x = ListRegisteredScriptsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRegisteredScriptsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegisteredScriptsOut) ProtoMessage() {}
+
+func (x *ListRegisteredScriptsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegisteredScriptsOut.ProtoReflect.Descriptor instead.
+func (*ListRegisteredScriptsOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListRegisteredScriptsOut) GetScripts() []*RegisteredScript {
+	if x != nil {
+		return x.Scripts
+	}
+	return nil
+}
+
+type ExecScriptIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name identifies the registered script to run.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// args contains the values of the script's parameters, keyed by parameter
+	// name. Values are given in text form and are parsed according to the
+	// parameter's type: decimal numbers for INT and FLOAT, "true" or "false" for
+	// BOOL, and a JSON document for JSON.
+	Args map[string]string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// timeout_ms is the time after which the agent gives up waiting for the
+	// script. If 0, the agent's default is used. The agent caps this to an upper
+	// bound.
+	//
+	// Note that Delve cannot interrupt a running script; the target remains
+	// halted until the script finishes, even if the call timed out.
+	TimeoutMs int32 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *ExecScriptIn) Reset() {
+	*x = ExecScriptIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecScriptIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScriptIn) ProtoMessage() {}
+
+func (x *ExecScriptIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecScriptIn.ProtoReflect.Descriptor instead.
+func (*ExecScriptIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ExecScriptIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecScriptIn) GetArgs() map[string]string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ExecScriptIn) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type ExecScriptOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// result_json is the script's result, as JSON.
+	ResultJson string `protobuf:"bytes,1,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`
+	// output is the script's print() output.
+	Output string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	// output_truncated is set if the output was longer than the agent's cap and
+	// was truncated.
+	OutputTruncated bool `protobuf:"varint,3,opt,name=output_truncated,json=outputTruncated,proto3" json:"output_truncated,omitempty"`
+	// version is the version of the script that was run.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ExecScriptOut) Reset() {
+	*x = ExecScriptOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecScriptOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScriptOut) ProtoMessage() {}
+
+func (x *ExecScriptOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecScriptOut.ProtoReflect.Descriptor instead.
+func (*ExecScriptOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ExecScriptOut) GetResultJson() string {
+	if x != nil {
+		return x.ResultJson
+	}
+	return ""
+}
+
+func (x *ExecScriptOut) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *ExecScriptOut) GetOutputTruncated() bool {
+	if x != nil {
+		return x.OutputTruncated
+	}
+	return false
+}
+
+func (x *ExecScriptOut) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ListProcessesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The results are processes that match one or more of these predicates.
+	Predicates []*ListProcessesIn_TargetSpec `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+}
+
+func (x *ListProcessesIn) Reset() {
+	*x = ListProcessesIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProcessesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProcessesIn) ProtoMessage() {}
+
+func (x *ListProcessesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProcessesIn.ProtoReflect.Descriptor instead.
+func (*ListProcessesIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListProcessesIn) GetPredicates() []*ListProcessesIn_TargetSpec {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
+type ListProcessesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reports contains an entry for every connected agent.
+	Reports []*AgentReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (x *ListProcessesOut) Reset() {
+	*x = ListProcessesOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProcessesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProcessesOut) ProtoMessage() {}
+
+func (x *ListProcessesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProcessesOut.ProtoReflect.Descriptor instead.
+func (*ListProcessesOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListProcessesOut) GetReports() []*AgentReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// AgentReport is the information reported by a single agent about the processes
+// of interest it recognized on its host.
+type AgentReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the host where the agent is running.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The IP addresses of the host where the agent is running.
+	IpAddress    [][]byte `protobuf:"bytes,2,rep,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	AgentVersion string   `protobuf:"bytes,3,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
+	// processes can be empty if the agent did not find any processes matching the
+	// predicates in the query.
+	Processes []*Process `protobuf:"bytes,4,rep,name=processes,proto3" json:"processes,omitempty"`
+	// agent_id is a unique identifier for the agent. The ID is referenced by the
+	// CaptureSnapshot RPC in order to identify which machine to collect process
+	// snapshots from. If the server has a stable, unique identifier for an agent
+	// (e.g. if the agent's IP or hostname are unique), then it will use that. If
+	// two agents cannot be distinguished by the server (e.g. if two agents are
+	// running on the same machine, probably by mistake), then the server will
+	// generate synthetic identifiers. In this case, however, the IDs are not
+	// usable after a server restart (i.e. CaptureSnapshot RPCs using them will
+	// fail).
+	//
+	// TODO: Ideally, such an agent identifier would not make it to the client. If
+	// the client does not have another unique way to identify between two or more
+	// agents (e.g. IP, hostname, PID of target process running on that agent's
+	// machine, etc.), then the ultimate human user also has no way to
+	// disambiguate between the respective agents, and thus they shouldn't be
+	// asked to. The server should thus find ways to deal with indistinguishable
+	// agents in a way that is transparent to the client.
+	AgentId string `protobuf:"bytes,5,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+}
+
+func (x *AgentReport) Reset() {
+	*x = AgentReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentReport) ProtoMessage() {}
+
+func (x *AgentReport) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentReport.ProtoReflect.Descriptor instead.
+func (*AgentReport) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AgentReport) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *AgentReport) GetIpAddress() [][]byte {
+	if x != nil {
+		return x.IpAddress
+	}
+	return nil
+}
+
+func (x *AgentReport) GetAgentVersion() string {
+	if x != nil {
+		return x.AgentVersion
+	}
+	return ""
+}
+
+func (x *AgentReport) GetProcesses() []*Process {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+func (x *AgentReport) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+type Process struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid     int32    `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Binary  *Binary  `protobuf:"bytes,2,opt,name=binary,proto3" json:"binary,omitempty"`
+	Command [][]byte `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
+	// The index(es) of the TargetSpec that matched this process within the
+	// ListProcessesIn.predicates.
+	MatchIdx int32 `protobuf:"varint,4,opt,name=match_idx,json=matchIdx,proto3" json:"match_idx,omitempty"`
+}
+
+func (x *Process) Reset() {
+	*x = Process{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Process) ProtoMessage() {}
+
+func (x *Process) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Process.ProtoReflect.Descriptor instead.
+func (*Process) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Process) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *Process) GetBinary() *Binary {
+	if x != nil {
+		return x.Binary
+	}
+	return nil
+}
+
+func (x *Process) GetCommand() [][]byte {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *Process) GetMatchIdx() int32 {
+	if x != nil {
+		return x.MatchIdx
+	}
+	return 0
+}
+
+type Binary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An identifier for the binary. The same binary will result in the same
+	// identifier every time.
+	ID   []byte `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Path []byte `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *Binary) Reset() {
+	*x = Binary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Binary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Binary) ProtoMessage() {}
+
+func (x *Binary) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Binary.ProtoReflect.Descriptor instead.
+func (*Binary) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Binary) GetID() []byte {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+func (x *Binary) GetPath() []byte {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type DownloadBinaryIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// binary_id identifies the binary to make durable.
+	BinaryId []byte `protobuf:"bytes,1,opt,name=binary_id,json=binaryId,proto3" json:"binary_id,omitempty"`
+	// processes_config has information useful for finding the binary. The
+	// assumption is that the binary corresponds to one or more processes returned
+	// by a ListProcesses(process_config) call.
+	ProcessesConfig *ListProcessesIn `protobuf:"bytes,2,opt,name=processes_config,json=processesConfig,proto3" json:"processes_config,omitempty"`
+}
+
+func (x *DownloadBinaryIn) Reset() {
+	*x = DownloadBinaryIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadBinaryIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBinaryIn) ProtoMessage() {}
+
+func (x *DownloadBinaryIn) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBinaryIn.ProtoReflect.Descriptor instead.
+func (*DownloadBinaryIn) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DownloadBinaryIn) GetBinaryId() []byte {
+	if x != nil {
+		return x.BinaryId
+	}
+	return nil
+}
+
+func (x *DownloadBinaryIn) GetProcessesConfig() *ListProcessesIn {
+	if x != nil {
+		return x.ProcessesConfig
+	}
+	return nil
+}
+
+type DownloadBinaryOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DownloadBinaryOut) Reset() {
+	*x = DownloadBinaryOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadBinaryOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBinaryOut) ProtoMessage() {}
+
+func (x *DownloadBinaryOut) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBinaryOut.ProtoReflect.Descriptor instead.
+func (*DownloadBinaryOut) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{82}
+}
+
+// LineRange restricts a FrameSpec to frames stopped on lines within
+// [start_line, end_line] of a file.
+type FrameSpec_LineRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// file, if not empty, needs to be a suffix of the frame's file path
+	// made of whole path elements. For example, "sql/conn.go" matches
+	// "pkg/sql/conn.go", but "a.go" does not match "pkg/data.go".
+	File      string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	StartLine int32  `protobuf:"varint,2,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	// end_line, if 0, is taken to be start_line.
+	EndLine int32 `protobuf:"varint,3,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
+}
+
+func (x *FrameSpec_LineRange) Reset() {
+	*x = FrameSpec_LineRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSpec_LineRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSpec_LineRange) ProtoMessage() {}
+
+func (x *FrameSpec_LineRange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSpec_LineRange.ProtoReflect.Descriptor instead.
+func (*FrameSpec_LineRange) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *FrameSpec_LineRange) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *FrameSpec_LineRange) GetStartLine() int32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *FrameSpec_LineRange) GetEndLine() int32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+// PCOffsetRange restricts a FrameSpec to frames whose program counter is
+// within [start, end), as offsets from the beginning of the function.
+type FrameSpec_PCOffsetRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// end, if 0, means that the range extends to the end of the function.
+	End int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *FrameSpec_PCOffsetRange) Reset() {
+	*x = FrameSpec_PCOffsetRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSpec_PCOffsetRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSpec_PCOffsetRange) ProtoMessage() {}
+
+func (x *FrameSpec_PCOffsetRange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSpec_PCOffsetRange.ProtoReflect.Descriptor instead.
+func (*FrameSpec_PCOffsetRange) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *FrameSpec_PCOffsetRange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *FrameSpec_PCOffsetRange) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// RelativeExpression is an expression to be evaluated in the scope of a
+// frame other than the one matching the FrameSpec, identified relative to the
+// matching frame. The captured value is attached to the frame in whose scope
+// the expression was evaluated. If no such frame exists, the expression is
+// not evaluated.
+type FrameSpec_RelativeExpression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// frame_offset identifies the frame by its distance from the matching
+	// frame. Positive values refer to callers (1 is the direct caller) and
+	// negative values refer to callees.
+	FrameOffset int32 `protobuf:"varint,2,opt,name=frame_offset,json=frameOffset,proto3" json:"frame_offset,omitempty"`
+	// enclosing_func, if set, identifies the frame as the nearest caller of
+	// the matching frame whose function matches enclosing_func according to
+	// enclosing_match_mode. frame_offset is ignored in this case.
+	EnclosingFunc      string              `protobuf:"bytes,3,opt,name=enclosing_func,json=enclosingFunc,proto3" json:"enclosing_func,omitempty"`
+	EnclosingMatchMode FrameSpec_MatchMode `protobuf:"varint,4,opt,name=enclosing_match_mode,json=enclosingMatchMode,proto3,enum=agentrpc.FrameSpec_MatchMode" json:"enclosing_match_mode,omitempty"`
+	// load_config, if set, overrides the FrameSpec's load_config for this
+	// expression.
+	LoadConfig *LoadConfig `protobuf:"bytes,5,opt,name=load_config,json=loadConfig,proto3" json:"load_config,omitempty"`
+}
+
+func (x *FrameSpec_RelativeExpression) Reset() {
+	*x = FrameSpec_RelativeExpression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSpec_RelativeExpression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSpec_RelativeExpression) ProtoMessage() {}
+
+func (x *FrameSpec_RelativeExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSpec_RelativeExpression.ProtoReflect.Descriptor instead.
+func (*FrameSpec_RelativeExpression) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{12, 2}
+}
+
+func (x *FrameSpec_RelativeExpression) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *FrameSpec_RelativeExpression) GetFrameOffset() int32 {
+	if x != nil {
+		return x.FrameOffset
+	}
+	return 0
+}
+
+func (x *FrameSpec_RelativeExpression) GetEnclosingFunc() string {
+	if x != nil {
+		return x.EnclosingFunc
+	}
+	return ""
+}
+
+func (x *FrameSpec_RelativeExpression) GetEnclosingMatchMode() FrameSpec_MatchMode {
+	if x != nil {
+		return x.EnclosingMatchMode
+	}
+	return FrameSpec_SUFFIX
+}
+
+func (x *FrameSpec_RelativeExpression) GetLoadConfig() *LoadConfig {
+	if x != nil {
+		return x.LoadConfig
+	}
+	return nil
+}
+
+// TargetSpec defines a predicate for matching processes. All present fields
+// are ANDed together.
+type ListProcessesIn_TargetSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname    string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	ProcessName string `protobuf:"bytes,2,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
+	BinaryPath  string `protobuf:"bytes,3,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`
+}
+
+func (x *ListProcessesIn_TargetSpec) Reset() {
+	*x = ListProcessesIn_TargetSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProcessesIn_TargetSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProcessesIn_TargetSpec) ProtoMessage() {}
+
+func (x *ListProcessesIn_TargetSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProcessesIn_TargetSpec.ProtoReflect.Descriptor instead.
+func (*ListProcessesIn_TargetSpec) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{76, 0}
+}
+
+func (x *ListProcessesIn_TargetSpec) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ListProcessesIn_TargetSpec) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
+	}
+	return ""
+}
+
+func (x *ListProcessesIn_TargetSpec) GetBinaryPath() string {
+	if x != nil {
+		return x.BinaryPath
+	}
+	return ""
+}
+
+var File_rpc_proto protoreflect.FileDescriptor
+
+var file_rpc_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x1a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x63, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x22, 0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x07, 0x56, 0x61, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x19, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c,
+	0x6f, 0x63, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22,
+	0x96, 0x01, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x61, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x2b, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x4e,
+	0x6f, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x72, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x63, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x63, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x30,
+	0x0a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x74, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0xba, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x72, 0x73, 0x4f, 0x75, 0x74,
+	0x12, 0x25, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a,
+	0x4c, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x28, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x46, 0x75, 0x6e, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x46, 0x75, 0x6e, 0x63, 0x73, 0x22, 0x58, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x24, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xaf, 0x03, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52,
+	0x10, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x02, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x72,
+	0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x6e, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xcb, 0x08, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x66, 0x0a, 0x17, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x49, 0x0a, 0x0f, 0x70, 0x63, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x43,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x70, 0x63, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x13, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x59, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65,
+	0x1a, 0x37, 0x0a, 0x0d, 0x50, 0x43, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x1a, 0x86, 0x02, 0x0a, 0x12, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67,
+	0x5f, 0x66, 0x75, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x63,
+	0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x12, 0x4f, 0x0a, 0x14, 0x65, 0x6e,
+	0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x1a, 0x5e, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x41, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x55, 0x46, 0x46, 0x49, 0x58, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45,
+	0x58, 0x41, 0x43, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x52, 0x45,
+	0x46, 0x49, 0x58, 0x10, 0x03, 0x22, 0xa1, 0x01, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x6c, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6c,
+	0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xeb, 0x03, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x12, 0x31,
+	0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12,
+	0x2b, 0x0a, 0x11, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x0b,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x49, 0x4d, 0x49, 0x4c, 0x41, 0x52, 0x5f, 0x41, 0x4e,
+	0x59, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x49, 0x4d,
+	0x49, 0x4c, 0x41, 0x52, 0x5f, 0x41, 0x4e, 0x59, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x22, 0xd2, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x72, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xd8, 0x02, 0x0a,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x61, 0x70, 0x12,
+	0x2b, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x12, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x3a, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x75, 0x6e, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x75, 0x6e, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d,
+	0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x90, 0x01, 0x0a,
+	0x09, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6f,
+	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x78, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x45, 0x78, 0x70, 0x72, 0x73, 0x22,
+	0xd3, 0x03, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4f,
+	0x75, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a,
+	0x14, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x13, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a,
+	0x18, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x67,
+	0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x16, 0x6e, 0x75, 0x6d, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x47, 0x6f, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x35,
+	0x0a, 0x17, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x14, 0x6e, 0x75, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x96, 0x03, 0x0a, 0x0d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x13, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x77,
+	0x61, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x73,
+	0x12, 0x33, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12,
+	0x3b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x6f, 0x72, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x70,
+	0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x6f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75,
+	0x6d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67,
+	0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x0c, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x73,
+	0x22, 0x6e, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x49,
+	0x6e, 0x22, 0x40, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73,
+	0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x70, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0xd6, 0x02, 0x0a, 0x0e, 0x57, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4f,
+	0x75, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x2e, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x75,
+	0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e,
+	0x6f, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4e,
+	0x61, 0x6e, 0x6f, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x72, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x8b, 0x03, 0x0a, 0x10, 0x46, 0x69, 0x6e,
+	0x64, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x34, 0x0a,
+	0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x11, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x65, 0x73, 0x49, 0x6e, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x30, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x53, 0x55, 0x42, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52,
+	0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x22, 0x50, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53,
+	0x70, 0x61, 0x77, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78,
+	0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0xb9, 0x02,
+	0x0a, 0x09, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x67,
+	0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x33,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
+	0x69, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x74,
+	0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x52, 0x0d, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12,
+	0x2f, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x61,
+	0x77, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x44, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x69, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb6, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x4f, 0x75, 0x74, 0x12,
+	0x29, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x69,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x73, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x25, 0x0a,
+	0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x65, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4c,
+	0x65, 0x61, 0x6b, 0x73, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e,
+	0x75, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x49, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x75, 0x0a, 0x0e,
+	0x53, 0x74, 0x75, 0x63, 0x6b, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x67, 0x72, 0x6f, 0x77,
+	0x74, 0x68, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x50, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x67, 0x6f, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x0e, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x6b, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x10,
+	0x73, 0x74, 0x75, 0x63, 0x6b, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
+	0x52, 0x0f, 0x73, 0x74, 0x75, 0x63, 0x6b, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
+	0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x63,
+	0x6b, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x35,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x11, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x6e, 0x69, 0x78,
+	0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x6f, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x6e,
+	0x75, 0x6d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x22, 0x5d, 0x0a,
+	0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x75, 0x6d, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x22, 0x90, 0x01, 0x0a,
+	0x0e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0xcf, 0x02, 0x0a, 0x0f, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x63, 0x6b,
+	0x53, 0x69, 0x74, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x74, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x76,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x76,
+	0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x45, 0x0a, 0x11,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x10, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x6d,
+	0x75, 0x74, 0x65, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x75, 0x74, 0x65, 0x78, 0x65, 0x73,
+	0x12, 0x34, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x14, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x57,
+	0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x22, 0x7b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x44,
+	0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4a,
+	0x73, 0x6f, 0x6e, 0x22, 0xbb, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x57,
+	0x61, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x57, 0x61, 0x69, 0x74,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x69, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x69, 0x6c, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x2e, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b,
+	0x0a, 0x07, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10,
+	0x02, 0x22, 0xed, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x6f,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x6e, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x77, 0x61, 0x69, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x57, 0x61, 0x69, 0x74, 0x52, 0x05,
+	0x77, 0x61, 0x69, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x16, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x64, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x12, 0x26, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x22, 0xef, 0x02, 0x0a, 0x0d, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x69,
+	0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x0e, 0x0a, 0x0a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x43, 0x4f, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x57, 0x5f,
+	0x4d, 0x55, 0x54, 0x45, 0x58, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x4d, 0x41, 0x50,
+	0x48, 0x4f, 0x52, 0x45, 0x10, 0x03, 0x22, 0x82, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63,
+	0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x49, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0xbc, 0x02, 0x0a, 0x0d, 0x4e,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x66, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x66, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x69, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75,
+	0x74, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x12, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e,
+	0x22, 0xc7, 0x01, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e,
+	0x0a, 0x0a, 0x67, 0x6f, 0x6d, 0x61, 0x78, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x67, 0x6f, 0x6d, 0x61, 0x78, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x69, 0x64, 0x6c, 0x65, 0x50, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x5f, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x75, 0x6d, 0x4d, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x69, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x70,
+	0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x75,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x75, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x75, 0x6e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x63, 0x61,
+	0x6c, 0x6c, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x79,
+	0x73, 0x63, 0x61, 0x6c, 0x6c, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x13, 0x0a, 0x05, 0x68, 0x61, 0x73,
+	0x5f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x61, 0x73, 0x4d, 0x22, 0x99,
+	0x02, 0x0a, 0x06, 0x47, 0x43, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x5f, 0x67,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x75, 0x6d, 0x47, 0x63, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x63, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x67, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a,
+	0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x63, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61,
+	0x6e, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x47,
+	0x63, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x6c,
+	0x69, 0x76, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x68, 0x65, 0x61, 0x70, 0x4c, 0x69, 0x76, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8d, 0x02, 0x0a, 0x08, 0x4d,
+	0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x70, 0x5f,
+	0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x68, 0x65, 0x61, 0x70, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x65, 0x61,
+	0x70, 0x46, 0x72, 0x65, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x65,
+	0x61, 0x70, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x68, 0x65, 0x61, 0x70, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x53, 0x79, 0x73, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x46, 0x72, 0x65, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x0a, 0x05, 0x73, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x63, 0x68, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x43, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x02, 0x67, 0x63, 0x12, 0x2f, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x6d, 0x65,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xba, 0x01, 0x0a, 0x0e, 0x47,
+	0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x70, 0x63, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61,
+	0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x84, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47,
+	0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67,
+	0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x8e,
+	0x01, 0x0a, 0x0e, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22,
+	0x92, 0x01, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e,
+	0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e,
+	0x10, 0x04, 0x22, 0xce, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x2d, 0x0a, 0x11, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4f, 0x75, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x12, 0x55, 0x6e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x73, 0x49, 0x6e, 0x22, 0x50, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x4f,
+	0x75, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52,
+	0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x65,
+	0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x49, 0x6e, 0x2e, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x4d, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8d, 0x01, 0x0a, 0x0d,
+	0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x01, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x12,
+	0x44, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x2e, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50,
+	0x61, 0x74, 0x68, 0x22, 0x43, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0b, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69,
+	0x64, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69,
+	0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x64, 0x78, 0x22, 0x2c, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x75, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x44, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x32, 0x9f, 0x03, 0x0a,
+	0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x4f,
+	0x75, 0x74, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x49, 0x6e, 0x1a, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x12,
+	0x46, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x4f, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x4f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x72,
+	0x73, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x32, 0xfd,
+	0x06, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x4f, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x57, 0x61, 0x6c, 0x6b, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x1a, 0x18,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x49, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64,
+	0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73,
+	0x4f, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x1a, 0x19, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53,
+	0x70, 0x61, 0x77, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x54, 0x72, 0x65, 0x65,
+	0x49, 0x6e, 0x1a, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x40, 0x0a,
+	0x0b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x6b, 0x73, 0x12, 0x17, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4c, 0x65,
+	0x61, 0x6b, 0x73, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x6b, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x49, 0x6e, 0x1a, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x4f, 0x75, 0x74, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x57,
+	0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73,
+	0x49, 0x6e, 0x1a, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x79, 0x6e, 0x63, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x49, 0x6e, 0x1a, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x4f, 0x75, 0x74, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x49,
+	0x6e, 0x1a, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x75, 0x74, 0x32, 0xca,
+	0x02, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x49, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x1a, 0x1b,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x4f, 0x0a, 0x10, 0x55,
+	0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
+	0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x1a, 0x1d, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x5e, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x22, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x3d, 0x0a, 0x0a,
+	0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x42, 0x2d, 0x5a, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x64, 0x72, 0x65, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x69, 0x2f, 0x64, 0x65, 0x6c, 0x76, 0x65, 0x2d, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_rpc_proto_rawDescOnce sync.Once
+	file_rpc_proto_rawDescData = file_rpc_proto_rawDesc
+)
+
+func file_rpc_proto_rawDescGZIP() []byte {
+	file_rpc_proto_rawDescOnce.Do(func() {
+		file_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_proto_rawDescData)
+	})
+	return file_rpc_proto_rawDescData
+}
+
+var file_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
+var file_rpc_proto_goTypes = []interface{}{
+	(FrameSpec_MatchMode)(0),             // 0: agentrpc.FrameSpec.MatchMode
+	(GetSnapshotIn_Aggregation)(0),       // 1: agentrpc.GetSnapshotIn.Aggregation
+	(FindGoroutinesIn_MatchMode)(0),      // 2: agentrpc.FindGoroutinesIn.MatchMode
+	(ChannelWait_Operation)(0),           // 3: agentrpc.ChannelWait.Operation
+	(SyncPrimitive_Kind)(0),              // 4: agentrpc.SyncPrimitive.Kind
+	(ScriptParam_Type)(0),                // 5: agentrpc.ScriptParam.Type
+	(*GetTypeInfoIn)(nil),                // 6: agentrpc.GetTypeInfoIn
+	(*FieldInfo)(nil),                    // 7: agentrpc.FieldInfo
+	(*GetTypeInfoOut)(nil),               // 8: agentrpc.GetTypeInfoOut
+	(*VarInfo)(nil),                      // 9: agentrpc.VarInfo
+	(*TypeInfo)(nil),                     // 10: agentrpc.TypeInfo
+	(*ListVarsIn)(nil),                   // 11: agentrpc.ListVarsIn
+	(*ListVarsOut)(nil),                  // 12: agentrpc.ListVarsOut
+	(*ListFunctionsIn)(nil),              // 13: agentrpc.ListFunctionsIn
+	(*ListFunctionsOut)(nil),             // 14: agentrpc.ListFunctionsOut
+	(*ListTypesIn)(nil),                  // 15: agentrpc.ListTypesIn
+	(*ListTypesOut)(nil),                 // 16: agentrpc.ListTypesOut
+	(*LoadConfig)(nil),                   // 17: agentrpc.LoadConfig
+	(*FrameSpec)(nil),                    // 18: agentrpc.FrameSpec
+	(*TypeSpec)(nil),                     // 19: agentrpc.TypeSpec
+	(*GetSnapshotIn)(nil),                // 20: agentrpc.GetSnapshotIn
+	(*ContextExtractor)(nil),             // 21: agentrpc.ContextExtractor
+	(*Value)(nil),                        // 22: agentrpc.Value
+	(*CapturedExpression)(nil),           // 23: agentrpc.CapturedExpression
+	(*ExpressionSummary)(nil),            // 24: agentrpc.ExpressionSummary
+	(*FrameData)(nil),                    // 25: agentrpc.FrameData
+	(*GetSnapshotOut)(nil),               // 26: agentrpc.GetSnapshotOut
+	(*GoroutineInfo)(nil),                // 27: agentrpc.GoroutineInfo
+	(*ValueGroup)(nil),                   // 28: agentrpc.ValueGroup
+	(*ScriptInfo)(nil),                   // 29: agentrpc.ScriptInfo
+	(*ListScriptsIn)(nil),                // 30: agentrpc.ListScriptsIn
+	(*ListScriptsOut)(nil),               // 31: agentrpc.ListScriptsOut
+	(*WalkContextIn)(nil),                // 32: agentrpc.WalkContextIn
+	(*ContextNode)(nil),                  // 33: agentrpc.ContextNode
+	(*ContextValue)(nil),                 // 34: agentrpc.ContextValue
+	(*WalkContextOut)(nil),               // 35: agentrpc.WalkContextOut
+	(*FindGoroutinesIn)(nil),             // 36: agentrpc.FindGoroutinesIn
+	(*StackFrame)(nil),                   // 37: agentrpc.StackFrame
+	(*GetSpawnTreeIn)(nil),               // 38: agentrpc.GetSpawnTreeIn
+	(*SpawnNode)(nil),                    // 39: agentrpc.SpawnNode
+	(*CreationSite)(nil),                 // 40: agentrpc.CreationSite
+	(*GetSpawnTreeOut)(nil),              // 41: agentrpc.GetSpawnTreeOut
+	(*DetectLeaksIn)(nil),                // 42: agentrpc.DetectLeaksIn
+	(*StuckGoroutine)(nil),               // 43: agentrpc.StuckGoroutine
+	(*GrowingStack)(nil),                 // 44: agentrpc.GrowingStack
+	(*DetectLeaksOut)(nil),               // 45: agentrpc.DetectLeaksOut
+	(*GetMutexContentionIn)(nil),         // 46: agentrpc.GetMutexContentionIn
+	(*LockSite)(nil),                     // 47: agentrpc.LockSite
+	(*FrameReference)(nil),               // 48: agentrpc.FrameReference
+	(*MutexContention)(nil),              // 49: agentrpc.MutexContention
+	(*GetMutexContentionOut)(nil),        // 50: agentrpc.GetMutexContentionOut
+	(*GetChannelGraphIn)(nil),            // 51: agentrpc.GetChannelGraphIn
+	(*ChannelWait)(nil),                  // 52: agentrpc.ChannelWait
+	(*ChannelInfo)(nil),                  // 53: agentrpc.ChannelInfo
+	(*GetChannelGraphOut)(nil),           // 54: agentrpc.GetChannelGraphOut
+	(*GetSyncWaitersIn)(nil),             // 55: agentrpc.GetSyncWaitersIn
+	(*SyncPrimitive)(nil),                // 56: agentrpc.SyncPrimitive
+	(*GetSyncWaitersOut)(nil),            // 57: agentrpc.GetSyncWaitersOut
+	(*GetNetConnectionsIn)(nil),          // 58: agentrpc.GetNetConnectionsIn
+	(*NetConnection)(nil),                // 59: agentrpc.NetConnection
+	(*GetNetConnectionsOut)(nil),         // 60: agentrpc.GetNetConnectionsOut
+	(*GetRuntimeInfoIn)(nil),             // 61: agentrpc.GetRuntimeInfoIn
+	(*SchedInfo)(nil),                    // 62: agentrpc.SchedInfo
+	(*ProcessorInfo)(nil),                // 63: agentrpc.ProcessorInfo
+	(*GCInfo)(nil),                       // 64: agentrpc.GCInfo
+	(*MemStats)(nil),                     // 65: agentrpc.MemStats
+	(*GetRuntimeInfoOut)(nil),            // 66: agentrpc.GetRuntimeInfoOut
+	(*GetGoroutineIn)(nil),               // 67: agentrpc.GetGoroutineIn
+	(*GoroutineFrame)(nil),               // 68: agentrpc.GoroutineFrame
+	(*GetGoroutineOut)(nil),              // 69: agentrpc.GetGoroutineOut
+	(*GoroutineMatch)(nil),               // 70: agentrpc.GoroutineMatch
+	(*FindGoroutinesOut)(nil),            // 71: agentrpc.FindGoroutinesOut
+	(*ScriptParam)(nil),                  // 72: agentrpc.ScriptParam
+	(*RegisteredScript)(nil),             // 73: agentrpc.RegisteredScript
+	(*RegisterScriptIn)(nil),             // 74: agentrpc.RegisterScriptIn
+	(*RegisterScriptOut)(nil),            // 75: agentrpc.RegisterScriptOut
+	(*UnregisterScriptIn)(nil),           // 76: agentrpc.UnregisterScriptIn
+	(*UnregisterScriptOut)(nil),          // 77: agentrpc.UnregisterScriptOut
+	(*ListRegisteredScriptsIn)(nil),      // 78: agentrpc.ListRegisteredScriptsIn
+	(*ListRegisteredScriptsOut)(nil),     // 79: agentrpc.ListRegisteredScriptsOut
+	(*ExecScriptIn)(nil),                 // 80: agentrpc.ExecScriptIn
+	(*ExecScriptOut)(nil),                // 81: agentrpc.ExecScriptOut
+	(*ListProcessesIn)(nil),              // 82: agentrpc.ListProcessesIn
+	(*ListProcessesOut)(nil),             // 83: agentrpc.ListProcessesOut
+	(*AgentReport)(nil),                  // 84: agentrpc.AgentReport
+	(*Process)(nil),                      // 85: agentrpc.Process
+	(*Binary)(nil),                       // 86: agentrpc.Binary
+	(*DownloadBinaryIn)(nil),             // 87: agentrpc.DownloadBinaryIn
+	(*DownloadBinaryOut)(nil),            // 88: agentrpc.DownloadBinaryOut
+	nil,                                  // 89: agentrpc.ListVarsOut.TypesEntry
+	(*FrameSpec_LineRange)(nil),          // 90: agentrpc.FrameSpec.LineRange
+	(*FrameSpec_PCOffsetRange)(nil),      // 91: agentrpc.FrameSpec.PCOffsetRange
+	(*FrameSpec_RelativeExpression)(nil), // 92: agentrpc.FrameSpec.RelativeExpression
+	nil,                                  // 93: agentrpc.FrameSpec.ExpressionLoadConfigsEntry
+	nil,                                  // 94: agentrpc.GoroutineInfo.LabelsEntry
+	nil,                                  // 95: agentrpc.CreationSite.StartFunctionsEntry
+	nil,                                  // 96: agentrpc.ExecScriptIn.ArgsEntry
+	(*ListProcessesIn_TargetSpec)(nil),   // 97: agentrpc.ListProcessesIn.TargetSpec
+	(*Profile)(nil),                      // 98: perftools.profiles.Profile
+}
+var file_rpc_proto_depIdxs = []int32{
+	7,   // 0: agentrpc.GetTypeInfoOut.fields:type_name -> agentrpc.FieldInfo
+	7,   // 1: agentrpc.TypeInfo.fields:type_name -> agentrpc.FieldInfo
+	9,   // 2: agentrpc.ListVarsOut.vars:type_name -> agentrpc.VarInfo
+	89,  // 3: agentrpc.ListVarsOut.types:type_name -> agentrpc.ListVarsOut.TypesEntry
+	17,  // 4: agentrpc.FrameSpec.load_config:type_name -> agentrpc.LoadConfig
+	93,  // 5: agentrpc.FrameSpec.expression_load_configs:type_name -> agentrpc.FrameSpec.ExpressionLoadConfigsEntry
+	0,   // 6: agentrpc.FrameSpec.match_mode:type_name -> agentrpc.FrameSpec.MatchMode
+	90,  // 7: agentrpc.FrameSpec.line_range:type_name -> agentrpc.FrameSpec.LineRange
+	91,  // 8: agentrpc.FrameSpec.pc_offset_range:type_name -> agentrpc.FrameSpec.PCOffsetRange
+	92,  // 9: agentrpc.FrameSpec.relative_expressions:type_name -> agentrpc.FrameSpec.RelativeExpression
+	17,  // 10: agentrpc.TypeSpec.load_config:type_name -> agentrpc.LoadConfig
+	18,  // 11: agentrpc.GetSnapshotIn.frame_specs:type_name -> agentrpc.FrameSpec
+	19,  // 12: agentrpc.GetSnapshotIn.type_specs:type_name -> agentrpc.TypeSpec
+	21,  // 13: agentrpc.GetSnapshotIn.context_extractors:type_name -> agentrpc.ContextExtractor
+	1,   // 14: agentrpc.GetSnapshotIn.aggregation:type_name -> agentrpc.GetSnapshotIn.Aggregation
+	17,  // 15: agentrpc.ContextExtractor.load_config:type_name -> agentrpc.LoadConfig
+	22,  // 16: agentrpc.Value.children:type_name -> agentrpc.Value
+	22,  // 17: agentrpc.CapturedExpression.structured_value:type_name -> agentrpc.Value
+	23,  // 18: agentrpc.FrameData.captured_exprs:type_name -> agentrpc.CapturedExpression
+	98,  // 19: agentrpc.GetSnapshotOut.profile:type_name -> perftools.profiles.Profile
+	25,  // 20: agentrpc.GetSnapshotOut.frame_data:type_name -> agentrpc.FrameData
+	24,  // 21: agentrpc.GetSnapshotOut.expression_summaries:type_name -> agentrpc.ExpressionSummary
+	29,  // 22: agentrpc.GetSnapshotOut.scripts:type_name -> agentrpc.ScriptInfo
+	28,  // 23: agentrpc.GetSnapshotOut.groups:type_name -> agentrpc.ValueGroup
+	27,  // 24: agentrpc.GetSnapshotOut.goroutines:type_name -> agentrpc.GoroutineInfo
+	37,  // 25: agentrpc.GoroutineInfo.created_by:type_name -> agentrpc.StackFrame
+	94,  // 26: agentrpc.GoroutineInfo.labels:type_name -> agentrpc.GoroutineInfo.LabelsEntry
+	29,  // 27: agentrpc.ListScriptsOut.scripts:type_name -> agentrpc.ScriptInfo
+	33,  // 28: agentrpc.WalkContextOut.chain:type_name -> agentrpc.ContextNode
+	34,  // 29: agentrpc.WalkContextOut.values:type_name -> agentrpc.ContextValue
+	18,  // 30: agentrpc.FindGoroutinesIn.frame_specs:type_name -> agentrpc.FrameSpec
+	21,  // 31: agentrpc.FindGoroutinesIn.context_extractors:type_name -> agentrpc.ContextExtractor
+	2,   // 32: agentrpc.FindGoroutinesIn.match_mode:type_name -> agentrpc.FindGoroutinesIn.MatchMode
+	37,  // 33: agentrpc.SpawnNode.created_by:type_name -> agentrpc.StackFrame
+	37,  // 34: agentrpc.SpawnNode.ancestor_stack:type_name -> agentrpc.StackFrame
+	39,  // 35: agentrpc.SpawnNode.children:type_name -> agentrpc.SpawnNode
+	37,  // 36: agentrpc.CreationSite.location:type_name -> agentrpc.StackFrame
+	95,  // 37: agentrpc.CreationSite.start_functions:type_name -> agentrpc.CreationSite.StartFunctionsEntry
+	39,  // 38: agentrpc.GetSpawnTreeOut.roots:type_name -> agentrpc.SpawnNode
+	40,  // 39: agentrpc.GetSpawnTreeOut.sites:type_name -> agentrpc.CreationSite
+	1,   // 40: agentrpc.DetectLeaksIn.aggregation:type_name -> agentrpc.GetSnapshotIn.Aggregation
+	37,  // 41: agentrpc.StuckGoroutine.stack:type_name -> agentrpc.StackFrame
+	37,  // 42: agentrpc.GrowingStack.stack:type_name -> agentrpc.StackFrame
+	43,  // 43: agentrpc.DetectLeaksOut.stuck_goroutines:type_name -> agentrpc.StuckGoroutine
+	44,  // 44: agentrpc.DetectLeaksOut.growing_stacks:type_name -> agentrpc.GrowingStack
+	98,  // 45: agentrpc.DetectLeaksOut.profile:type_name -> perftools.profiles.Profile
+	37,  // 46: agentrpc.LockSite.location:type_name -> agentrpc.StackFrame
+	37,  // 47: agentrpc.FrameReference.frame:type_name -> agentrpc.StackFrame
+	47,  // 48: agentrpc.MutexContention.lock_sites:type_name -> agentrpc.LockSite
+	48,  // 49: agentrpc.MutexContention.holder_candidates:type_name -> agentrpc.FrameReference
+	49,  // 50: agentrpc.GetMutexContentionOut.mutexes:type_name -> agentrpc.MutexContention
+	3,   // 51: agentrpc.ChannelWait.operation:type_name -> agentrpc.ChannelWait.Operation
+	37,  // 52: agentrpc.ChannelWait.location:type_name -> agentrpc.StackFrame
+	48,  // 53: agentrpc.ChannelInfo.references:type_name -> agentrpc.FrameReference
+	52,  // 54: agentrpc.GetChannelGraphOut.waits:type_name -> agentrpc.ChannelWait
+	53,  // 55: agentrpc.GetChannelGraphOut.channels:type_name -> agentrpc.ChannelInfo
+	4,   // 56: agentrpc.SyncPrimitive.kind:type_name -> agentrpc.SyncPrimitive.Kind
+	56,  // 57: agentrpc.GetSyncWaitersOut.primitives:type_name -> agentrpc.SyncPrimitive
+	37,  // 58: agentrpc.NetConnection.location:type_name -> agentrpc.StackFrame
+	59,  // 59: agentrpc.GetNetConnectionsOut.connections:type_name -> agentrpc.NetConnection
+	62,  // 60: agentrpc.GetRuntimeInfoOut.sched:type_name -> agentrpc.SchedInfo
+	63,  // 61: agentrpc.GetRuntimeInfoOut.processors:type_name -> agentrpc.ProcessorInfo
+	64,  // 62: agentrpc.GetRuntimeInfoOut.gc:type_name -> agentrpc.GCInfo
+	65,  // 63: agentrpc.GetRuntimeInfoOut.mem_stats:type_name -> agentrpc.MemStats
+	17,  // 64: agentrpc.GetGoroutineIn.load_config:type_name -> agentrpc.LoadConfig
+	37,  // 65: agentrpc.GoroutineFrame.frame:type_name -> agentrpc.StackFrame
+	22,  // 66: agentrpc.GoroutineFrame.arguments:type_name -> agentrpc.Value
+	22,  // 67: agentrpc.GoroutineFrame.locals:type_name -> agentrpc.Value
+	68,  // 68: agentrpc.GetGoroutineOut.frames:type_name -> agentrpc.GoroutineFrame
+	37,  // 69: agentrpc.GoroutineMatch.stack:type_name -> agentrpc.StackFrame
+	25,  // 70: agentrpc.GoroutineMatch.matches:type_name -> agentrpc.FrameData
+	70,  // 71: agentrpc.FindGoroutinesOut.goroutines:type_name -> agentrpc.GoroutineMatch
+	5,   // 72: agentrpc.ScriptParam.type:type_name -> agentrpc.ScriptParam.Type
+	72,  // 73: agentrpc.RegisteredScript.params:type_name -> agentrpc.ScriptParam
+	73,  // 74: agentrpc.RegisterScriptIn.script:type_name -> agentrpc.RegisteredScript
+	73,  // 75: agentrpc.ListRegisteredScriptsOut.scripts:type_name -> agentrpc.RegisteredScript
+	96,  // 76: agentrpc.ExecScriptIn.args:type_name -> agentrpc.ExecScriptIn.ArgsEntry
+	97,  // 77: agentrpc.ListProcessesIn.predicates:type_name -> agentrpc.ListProcessesIn.TargetSpec
+	84,  // 78: agentrpc.ListProcessesOut.reports:type_name -> agentrpc.AgentReport
+	85,  // 79: agentrpc.AgentReport.processes:type_name -> agentrpc.Process
+	86,  // 80: agentrpc.Process.binary:type_name -> agentrpc.Binary
+	82,  // 81: agentrpc.DownloadBinaryIn.processes_config:type_name -> agentrpc.ListProcessesIn
+	10,  // 82: agentrpc.ListVarsOut.TypesEntry.value:type_name -> agentrpc.TypeInfo
+	0,   // 83: agentrpc.FrameSpec.RelativeExpression.enclosing_match_mode:type_name -> agentrpc.FrameSpec.MatchMode
+	17,  // 84: agentrpc.FrameSpec.RelativeExpression.load_config:type_name -> agentrpc.LoadConfig
+	17,  // 85: agentrpc.FrameSpec.ExpressionLoadConfigsEntry.value:type_name -> agentrpc.LoadConfig
+	82,  // 86: agentrpc.DebugInfo.ListProcesses:input_type -> agentrpc.ListProcessesIn
+	87,  // 87: agentrpc.DebugInfo.DownloadBinary:input_type -> agentrpc.DownloadBinaryIn
+	13,  // 88: agentrpc.DebugInfo.ListFunctions:input_type -> agentrpc.ListFunctionsIn
+	15,  // 89: agentrpc.DebugInfo.ListTypes:input_type -> agentrpc.ListTypesIn
+	6,   // 90: agentrpc.DebugInfo.GetTypeInfo:input_type -> agentrpc.GetTypeInfoIn
+	11,  // 91: agentrpc.DebugInfo.ListVars:input_type -> agentrpc.ListVarsIn
+	20,  // 92: agentrpc.SnapshotService.GetSnapshot:input_type -> agentrpc.GetSnapshotIn
+	30,  // 93: agentrpc.SnapshotService.ListScripts:input_type -> agentrpc.ListScriptsIn
+	32,  // 94: agentrpc.SnapshotService.WalkContext:input_type -> agentrpc.WalkContextIn
+	36,  // 95: agentrpc.SnapshotService.FindGoroutines:input_type -> agentrpc.FindGoroutinesIn
+	67,  // 96: agentrpc.SnapshotService.GetGoroutine:input_type -> agentrpc.GetGoroutineIn
+	38,  // 97: agentrpc.SnapshotService.GetSpawnTree:input_type -> agentrpc.GetSpawnTreeIn
+	42,  // 98: agentrpc.SnapshotService.DetectLeaks:input_type -> agentrpc.DetectLeaksIn
+	46,  // 99: agentrpc.SnapshotService.GetMutexContention:input_type -> agentrpc.GetMutexContentionIn
+	51,  // 100: agentrpc.SnapshotService.GetChannelGraph:input_type -> agentrpc.GetChannelGraphIn
+	55,  // 101: agentrpc.SnapshotService.GetSyncWaiters:input_type -> agentrpc.GetSyncWaitersIn
+	58,  // 102: agentrpc.SnapshotService.GetNetConnections:input_type -> agentrpc.GetNetConnectionsIn
+	61,  // 103: agentrpc.SnapshotService.GetRuntimeInfo:input_type -> agentrpc.GetRuntimeInfoIn
+	74,  // 104: agentrpc.ScriptService.RegisterScript:input_type -> agentrpc.RegisterScriptIn
+	76,  // 105: agentrpc.ScriptService.UnregisterScript:input_type -> agentrpc.UnregisterScriptIn
+	78,  // 106: agentrpc.ScriptService.ListRegisteredScripts:input_type -> agentrpc.ListRegisteredScriptsIn
+	80,  // 107: agentrpc.ScriptService.ExecScript:input_type -> agentrpc.ExecScriptIn
+	83,  // 108: agentrpc.DebugInfo.ListProcesses:output_type -> agentrpc.ListProcessesOut
+	88,  // 109: agentrpc.DebugInfo.DownloadBinary:output_type -> agentrpc.DownloadBinaryOut
+	14,  // 110: agentrpc.DebugInfo.ListFunctions:output_type -> agentrpc.ListFunctionsOut
+	16,  // 111: agentrpc.DebugInfo.ListTypes:output_type -> agentrpc.ListTypesOut
+	8,   // 112: agentrpc.DebugInfo.GetTypeInfo:output_type -> agentrpc.GetTypeInfoOut
+	12,  // 113: agentrpc.DebugInfo.ListVars:output_type -> agentrpc.ListVarsOut
+	26,  // 114: agentrpc.SnapshotService.GetSnapshot:output_type -> agentrpc.GetSnapshotOut
+	31,  // 115: agentrpc.SnapshotService.ListScripts:output_type -> agentrpc.ListScriptsOut
+	35,  // 116: agentrpc.SnapshotService.WalkContext:output_type -> agentrpc.WalkContextOut
+	71,  // 117: agentrpc.SnapshotService.FindGoroutines:output_type -> agentrpc.FindGoroutinesOut
+	69,  // 118: agentrpc.SnapshotService.GetGoroutine:output_type -> agentrpc.GetGoroutineOut
+	41,  // 119: agentrpc.SnapshotService.GetSpawnTree:output_type -> agentrpc.GetSpawnTreeOut
+	45,  // 120: agentrpc.SnapshotService.DetectLeaks:output_type -> agentrpc.DetectLeaksOut
+	50,  // 121: agentrpc.SnapshotService.GetMutexContention:output_type -> agentrpc.GetMutexContentionOut
+	54,  // 122: agentrpc.SnapshotService.GetChannelGraph:output_type -> agentrpc.GetChannelGraphOut
+	57,  // 123: agentrpc.SnapshotService.GetSyncWaiters:output_type -> agentrpc.GetSyncWaitersOut
+	60,  // 124: agentrpc.SnapshotService.GetNetConnections:output_type -> agentrpc.GetNetConnectionsOut
+	66,  // 125: agentrpc.SnapshotService.GetRuntimeInfo:output_type -> agentrpc.GetRuntimeInfoOut
+	75,  // 126: agentrpc.ScriptService.RegisterScript:output_type -> agentrpc.RegisterScriptOut
+	77,  // 127: agentrpc.ScriptService.UnregisterScript:output_type -> agentrpc.UnregisterScriptOut
+	79,  // 128: agentrpc.ScriptService.ListRegisteredScripts:output_type -> agentrpc.ListRegisteredScriptsOut
+	81,  // 129: agentrpc.ScriptService.ExecScript:output_type -> agentrpc.ExecScriptOut
+	108, // [108:130] is the sub-list for method output_type
+	86,  // [86:108] is the sub-list for method input_type
+	86,  // [86:86] is the sub-list for extension type_name
+	86,  // [86:86] is the sub-list for extension extendee
+	0,   // [0:86] is the sub-list for field type_name
+}
+
+func init() { file_rpc_proto_init() }
+func file_rpc_proto_init() {
+	if File_rpc_proto != nil {
+		return
+	}
+	file_profile_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTypeInfoIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTypeInfoOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VarInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVarsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVarsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFunctionsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFunctionsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTypesIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTypesOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnapshotIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContextExtractor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapturedExpression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpressionSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnapshotOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoroutineInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValueGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScriptInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScriptsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScriptsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalkContextIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContextNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContextValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalkContextOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindGoroutinesIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StackFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpawnTreeIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpawnNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreationSite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpawnTreeOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectLeaksIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StuckGoroutine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrowingStack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectLeaksOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMutexContentionIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockSite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutexContention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMutexContentionOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelGraphIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelWait); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelGraphOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncWaitersIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncPrimitive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncWaitersOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetConnectionsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetConnectionsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRuntimeInfoIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1883,8 +8939,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldInfo); i {
+		file_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1895,8 +8951,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTypeInfoOut); i {
+		file_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessorInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1907,8 +8963,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VarInfo); i {
+		file_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1919,8 +8975,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeInfo); i {
+		file_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1931,8 +8987,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVarsIn); i {
+		file_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRuntimeInfoOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1943,8 +8999,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVarsOut); i {
+		file_rpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGoroutineIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1955,8 +9011,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFunctionsIn); i {
+		file_rpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoroutineFrame); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1967,8 +9023,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFunctionsOut); i {
+		file_rpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGoroutineOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1979,8 +9035,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTypesIn); i {
+		file_rpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoroutineMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1991,8 +9047,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTypesOut); i {
+		file_rpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindGoroutinesOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2003,8 +9059,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrameSpec); i {
+		file_rpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScriptParam); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2015,8 +9071,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeSpec); i {
+		file_rpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredScript); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2027,8 +9083,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSnapshotIn); i {
+		file_rpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterScriptIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2039,8 +9095,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CapturedExpression); i {
+		file_rpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterScriptOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2051,8 +9107,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrameData); i {
+		file_rpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnregisterScriptIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2063,8 +9119,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSnapshotOut); i {
+		file_rpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnregisterScriptOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2075,7 +9131,55 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegisteredScriptsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegisteredScriptsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecScriptIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecScriptOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListProcessesIn); i {
 			case 0:
 				return &v.state
@@ -2087,7 +9191,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListProcessesOut); i {
 			case 0:
 				return &v.state
@@ -2099,7 +9203,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AgentReport); i {
 			case 0:
 				return &v.state
@@ -2111,7 +9215,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Process); i {
 			case 0:
 				return &v.state
@@ -2123,7 +9227,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Binary); i {
 			case 0:
 				return &v.state
@@ -2135,7 +9239,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadBinaryIn); i {
 			case 0:
 				return &v.state
@@ -2147,7 +9251,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadBinaryOut); i {
 			case 0:
 				return &v.state
@@ -2159,7 +9263,43 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSpec_LineRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSpec_PCOffsetRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSpec_RelativeExpression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListProcessesIn_TargetSpec); i {
 			case 0:
 				return &v.state
@@ -2172,18 +9312,20 @@ func file_rpc_proto_init() {
 			}
 		}
 	}
+	file_rpc_proto_msgTypes[11].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   26,
+			NumEnums:      6,
+			NumMessages:   92,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_rpc_proto_goTypes,
 		DependencyIndexes: file_rpc_proto_depIdxs,
+		EnumInfos:         file_rpc_proto_enumTypes,
 		MessageInfos:      file_rpc_proto_msgTypes,
 	}.Build()
 	File_rpc_proto = out.File
@@ -348,7 +348,18 @@ var DebugInfo_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	SnapshotService_GetSnapshot_FullMethodName = "/agentrpc.SnapshotService/GetSnapshot"
+	SnapshotService_GetSnapshot_FullMethodName        = "/agentrpc.SnapshotService/GetSnapshot"
+	SnapshotService_ListScripts_FullMethodName        = "/agentrpc.SnapshotService/ListScripts"
+	SnapshotService_WalkContext_FullMethodName        = "/agentrpc.SnapshotService/WalkContext"
+	SnapshotService_FindGoroutines_FullMethodName     = "/agentrpc.SnapshotService/FindGoroutines"
+	SnapshotService_GetGoroutine_FullMethodName       = "/agentrpc.SnapshotService/GetGoroutine"
+	SnapshotService_GetSpawnTree_FullMethodName       = "/agentrpc.SnapshotService/GetSpawnTree"
+	SnapshotService_DetectLeaks_FullMethodName        = "/agentrpc.SnapshotService/DetectLeaks"
+	SnapshotService_GetMutexContention_FullMethodName = "/agentrpc.SnapshotService/GetMutexContention"
+	SnapshotService_GetChannelGraph_FullMethodName    = "/agentrpc.SnapshotService/GetChannelGraph"
+	SnapshotService_GetSyncWaiters_FullMethodName     = "/agentrpc.SnapshotService/GetSyncWaiters"
+	SnapshotService_GetNetConnections_FullMethodName  = "/agentrpc.SnapshotService/GetNetConnections"
+	SnapshotService_GetRuntimeInfo_FullMethodName     = "/agentrpc.SnapshotService/GetRuntimeInfo"
 )
 
 // SnapshotServiceClient is the client API for SnapshotService service.
@@ -356,6 +367,42 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SnapshotServiceClient interface {
 	GetSnapshot(ctx context.Context, in *GetSnapshotIn, opts ...grpc.CallOption) (*GetSnapshotOut, error)
+	// ListScripts returns information about the scripts loaded by the agent.
+	ListScripts(ctx context.Context, in *ListScriptsIn, opts ...grpc.CallOption) (*ListScriptsOut, error)
+	// WalkContext walks the chain of context.Context values reachable from an
+	// expression evaluated in a frame of a goroutine, and reports the values,
+	// deadline and cancellation state of the context.
+	WalkContext(ctx context.Context, in *WalkContextIn, opts ...grpc.CallOption) (*WalkContextOut, error)
+	// FindGoroutines searches the goroutines for the ones for which some captured
+	// value (e.g. a trace ID) matches a given value.
+	FindGoroutines(ctx context.Context, in *FindGoroutinesIn, opts ...grpc.CallOption) (*FindGoroutinesOut, error)
+	// GetGoroutine returns the stack of one goroutine, with the arguments and
+	// local variables of every frame.
+	GetGoroutine(ctx context.Context, in *GetGoroutineIn, opts ...grpc.CallOption) (*GetGoroutineOut, error)
+	// GetSpawnTree returns the tree of goroutines linked by which goroutine
+	// created which, and the number of goroutines created by each go statement.
+	GetSpawnTree(ctx context.Context, in *GetSpawnTreeIn, opts ...grpc.CallOption) (*GetSpawnTreeOut, error)
+	// DetectLeaks takes several snapshots spaced over time and reports the
+	// goroutines that stayed blocked at the same stack, and the stacks whose
+	// number of goroutines keeps growing.
+	DetectLeaks(ctx context.Context, in *DetectLeaksIn, opts ...grpc.CallOption) (*DetectLeaksOut, error)
+	// GetMutexContention groups the goroutines waiting to lock mutexes by mutex,
+	// and looks for the goroutines that probably hold the mutexes.
+	GetMutexContention(ctx context.Context, in *GetMutexContentionIn, opts ...grpc.CallOption) (*GetMutexContentionOut, error)
+	// GetChannelGraph builds the wait-for graph of the goroutines blocked on
+	// channels, and flags probable deadlocks.
+	GetChannelGraph(ctx context.Context, in *GetChannelGraphIn, opts ...grpc.CallOption) (*GetChannelGraphOut, error)
+	// GetSyncWaiters groups the goroutines blocked on WaitGroups, Conds,
+	// RWMutexes and runtime semaphores by the primitive they wait on.
+	GetSyncWaiters(ctx context.Context, in *GetSyncWaitersIn, opts ...grpc.CallOption) (*GetSyncWaitersOut, error)
+	// GetNetConnections lists the goroutines blocked on network I/O, together
+	// with the sockets they are blocked on. The sockets are looked up in the
+	// target's /proc entries, so the agent needs to run on the same machine as
+	// the target.
+	GetNetConnections(ctx context.Context, in *GetNetConnectionsIn, opts ...grpc.CallOption) (*GetNetConnectionsOut, error)
+	// GetRuntimeInfo reads the state of the target's scheduler, garbage
+	// collector and memory allocator from the runtime's globals.
+	GetRuntimeInfo(ctx context.Context, in *GetRuntimeInfoIn, opts ...grpc.CallOption) (*GetRuntimeInfoOut, error)
 }
 
 type snapshotServiceClient struct {
@@ -375,11 +422,146 @@ func (c *snapshotServiceClient) GetSnapshot(ctx context.Context, in *GetSnapshot
 	return out, nil
 }
 
+func (c *snapshotServiceClient) ListScripts(ctx context.Context, in *ListScriptsIn, opts ...grpc.CallOption) (*ListScriptsOut, error) {
+	out := new(ListScriptsOut)
+	err := c.cc.Invoke(ctx, SnapshotService_ListScripts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) WalkContext(ctx context.Context, in *WalkContextIn, opts ...grpc.CallOption) (*WalkContextOut, error) {
+	out := new(WalkContextOut)
+	err := c.cc.Invoke(ctx, SnapshotService_WalkContext_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) FindGoroutines(ctx context.Context, in *FindGoroutinesIn, opts ...grpc.CallOption) (*FindGoroutinesOut, error) {
+	out := new(FindGoroutinesOut)
+	err := c.cc.Invoke(ctx, SnapshotService_FindGoroutines_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) GetGoroutine(ctx context.Context, in *GetGoroutineIn, opts ...grpc.CallOption) (*GetGoroutineOut, error) {
+	out := new(GetGoroutineOut)
+	err := c.cc.Invoke(ctx, SnapshotService_GetGoroutine_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) GetSpawnTree(ctx context.Context, in *GetSpawnTreeIn, opts ...grpc.CallOption) (*GetSpawnTreeOut, error) {
+	out := new(GetSpawnTreeOut)
+	err := c.cc.Invoke(ctx, SnapshotService_GetSpawnTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) DetectLeaks(ctx context.Context, in *DetectLeaksIn, opts ...grpc.CallOption) (*DetectLeaksOut, error) {
+	out := new(DetectLeaksOut)
+	err := c.cc.Invoke(ctx, SnapshotService_DetectLeaks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) GetMutexContention(ctx context.Context, in *GetMutexContentionIn, opts ...grpc.CallOption) (*GetMutexContentionOut, error) {
+	out := new(GetMutexContentionOut)
+	err := c.cc.Invoke(ctx, SnapshotService_GetMutexContention_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) GetChannelGraph(ctx context.Context, in *GetChannelGraphIn, opts ...grpc.CallOption) (*GetChannelGraphOut, error) {
+	out := new(GetChannelGraphOut)
+	err := c.cc.Invoke(ctx, SnapshotService_GetChannelGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) GetSyncWaiters(ctx context.Context, in *GetSyncWaitersIn, opts ...grpc.CallOption) (*GetSyncWaitersOut, error) {
+	out := new(GetSyncWaitersOut)
+	err := c.cc.Invoke(ctx, SnapshotService_GetSyncWaiters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) GetNetConnections(ctx context.Context, in *GetNetConnectionsIn, opts ...grpc.CallOption) (*GetNetConnectionsOut, error) {
+	out := new(GetNetConnectionsOut)
+	err := c.cc.Invoke(ctx, SnapshotService_GetNetConnections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) GetRuntimeInfo(ctx context.Context, in *GetRuntimeInfoIn, opts ...grpc.CallOption) (*GetRuntimeInfoOut, error) {
+	out := new(GetRuntimeInfoOut)
+	err := c.cc.Invoke(ctx, SnapshotService_GetRuntimeInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SnapshotServiceServer is the server API for SnapshotService service.
 // All implementations must embed UnimplementedSnapshotServiceServer
 // for forward compatibility
 type SnapshotServiceServer interface {
 	GetSnapshot(context.Context, *GetSnapshotIn) (*GetSnapshotOut, error)
+	// ListScripts returns information about the scripts loaded by the agent.
+	ListScripts(context.Context, *ListScriptsIn) (*ListScriptsOut, error)
+	// WalkContext walks the chain of context.Context values reachable from an
+	// expression evaluated in a frame of a goroutine, and reports the values,
+	// deadline and cancellation state of the context.
+	WalkContext(context.Context, *WalkContextIn) (*WalkContextOut, error)
+	// FindGoroutines searches the goroutines for the ones for which some captured
+	// value (e.g. a trace ID) matches a given value.
+	FindGoroutines(context.Context, *FindGoroutinesIn) (*FindGoroutinesOut, error)
+	// GetGoroutine returns the stack of one goroutine, with the arguments and
+	// local variables of every frame.
+	GetGoroutine(context.Context, *GetGoroutineIn) (*GetGoroutineOut, error)
+	// GetSpawnTree returns the tree of goroutines linked by which goroutine
+	// created which, and the number of goroutines created by each go statement.
+	GetSpawnTree(context.Context, *GetSpawnTreeIn) (*GetSpawnTreeOut, error)
+	// DetectLeaks takes several snapshots spaced over time and reports the
+	// goroutines that stayed blocked at the same stack, and the stacks whose
+	// number of goroutines keeps growing.
+	DetectLeaks(context.Context, *DetectLeaksIn) (*DetectLeaksOut, error)
+	// GetMutexContention groups the goroutines waiting to lock mutexes by mutex,
+	// and looks for the goroutines that probably hold the mutexes.
+	GetMutexContention(context.Context, *GetMutexContentionIn) (*GetMutexContentionOut, error)
+	// GetChannelGraph builds the wait-for graph of the goroutines blocked on
+	// channels, and flags probable deadlocks.
+	GetChannelGraph(context.Context, *GetChannelGraphIn) (*GetChannelGraphOut, error)
+	// GetSyncWaiters groups the goroutines blocked on WaitGroups, Conds,
+	// RWMutexes and runtime semaphores by the primitive they wait on.
+	GetSyncWaiters(context.Context, *GetSyncWaitersIn) (*GetSyncWaitersOut, error)
+	// GetNetConnections lists the goroutines blocked on network I/O, together
+	// with the sockets they are blocked on. The sockets are looked up in the
+	// target's /proc entries, so the agent needs to run on the same machine as
+	// the target.
+	GetNetConnections(context.Context, *GetNetConnectionsIn) (*GetNetConnectionsOut, error)
+	// GetRuntimeInfo reads the state of the target's scheduler, garbage
+	// collector and memory allocator from the runtime's globals.
+	GetRuntimeInfo(context.Context, *GetRuntimeInfoIn) (*GetRuntimeInfoOut, error)
 	mustEmbedUnimplementedSnapshotServiceServer()
 }
 
@@ -390,6 +572,39 @@ type UnimplementedSnapshotServiceServer struct {
 func (UnimplementedSnapshotServiceServer) GetSnapshot(context.Context, *GetSnapshotIn) (*GetSnapshotOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
 }
+func (UnimplementedSnapshotServiceServer) ListScripts(context.Context, *ListScriptsIn) (*ListScriptsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScripts not implemented")
+}
+func (UnimplementedSnapshotServiceServer) WalkContext(context.Context, *WalkContextIn) (*WalkContextOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalkContext not implemented")
+}
+func (UnimplementedSnapshotServiceServer) FindGoroutines(context.Context, *FindGoroutinesIn) (*FindGoroutinesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindGoroutines not implemented")
+}
+func (UnimplementedSnapshotServiceServer) GetGoroutine(context.Context, *GetGoroutineIn) (*GetGoroutineOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoroutine not implemented")
+}
+func (UnimplementedSnapshotServiceServer) GetSpawnTree(context.Context, *GetSpawnTreeIn) (*GetSpawnTreeOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpawnTree not implemented")
+}
+func (UnimplementedSnapshotServiceServer) DetectLeaks(context.Context, *DetectLeaksIn) (*DetectLeaksOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectLeaks not implemented")
+}
+func (UnimplementedSnapshotServiceServer) GetMutexContention(context.Context, *GetMutexContentionIn) (*GetMutexContentionOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutexContention not implemented")
+}
+func (UnimplementedSnapshotServiceServer) GetChannelGraph(context.Context, *GetChannelGraphIn) (*GetChannelGraphOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelGraph not implemented")
+}
+func (UnimplementedSnapshotServiceServer) GetSyncWaiters(context.Context, *GetSyncWaitersIn) (*GetSyncWaitersOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncWaiters not implemented")
+}
+func (UnimplementedSnapshotServiceServer) GetNetConnections(context.Context, *GetNetConnectionsIn) (*GetNetConnectionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetConnections not implemented")
+}
+func (UnimplementedSnapshotServiceServer) GetRuntimeInfo(context.Context, *GetRuntimeInfoIn) (*GetRuntimeInfoOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuntimeInfo not implemented")
+}
 func (UnimplementedSnapshotServiceServer) mustEmbedUnimplementedSnapshotServiceServer() {}
 
 // UnsafeSnapshotServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -421,6 +636,204 @@ func _SnapshotService_GetSnapshot_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SnapshotService_ListScripts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScriptsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).ListScripts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_ListScripts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).ListScripts(ctx, req.(*ListScriptsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_WalkContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalkContextIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).WalkContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_WalkContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).WalkContext(ctx, req.(*WalkContextIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_FindGoroutines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindGoroutinesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).FindGoroutines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_FindGoroutines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).FindGoroutines(ctx, req.(*FindGoroutinesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_GetGoroutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoroutineIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).GetGoroutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_GetGoroutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).GetGoroutine(ctx, req.(*GetGoroutineIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_GetSpawnTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpawnTreeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).GetSpawnTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_GetSpawnTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).GetSpawnTree(ctx, req.(*GetSpawnTreeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_DetectLeaks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectLeaksIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).DetectLeaks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_DetectLeaks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).DetectLeaks(ctx, req.(*DetectLeaksIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_GetMutexContention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutexContentionIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).GetMutexContention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_GetMutexContention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).GetMutexContention(ctx, req.(*GetMutexContentionIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_GetChannelGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelGraphIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).GetChannelGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_GetChannelGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).GetChannelGraph(ctx, req.(*GetChannelGraphIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_GetSyncWaiters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncWaitersIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).GetSyncWaiters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_GetSyncWaiters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).GetSyncWaiters(ctx, req.(*GetSyncWaitersIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_GetNetConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetConnectionsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).GetNetConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_GetNetConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).GetNetConnections(ctx, req.(*GetNetConnectionsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_GetRuntimeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuntimeInfoIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).GetRuntimeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_GetRuntimeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).GetRuntimeInfo(ctx, req.(*GetRuntimeInfoIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SnapshotService_ServiceDesc is the grpc.ServiceDesc for SnapshotService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -432,6 +845,261 @@ var SnapshotService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSnapshot",
 			Handler:    _SnapshotService_GetSnapshot_Handler,
 		},
+		{
+			MethodName: "ListScripts",
+			Handler:    _SnapshotService_ListScripts_Handler,
+		},
+		{
+			MethodName: "WalkContext",
+			Handler:    _SnapshotService_WalkContext_Handler,
+		},
+		{
+			MethodName: "FindGoroutines",
+			Handler:    _SnapshotService_FindGoroutines_Handler,
+		},
+		{
+			MethodName: "GetGoroutine",
+			Handler:    _SnapshotService_GetGoroutine_Handler,
+		},
+		{
+			MethodName: "GetSpawnTree",
+			Handler:    _SnapshotService_GetSpawnTree_Handler,
+		},
+		{
+			MethodName: "DetectLeaks",
+			Handler:    _SnapshotService_DetectLeaks_Handler,
+		},
+		{
+			MethodName: "GetMutexContention",
+			Handler:    _SnapshotService_GetMutexContention_Handler,
+		},
+		{
+			MethodName: "GetChannelGraph",
+			Handler:    _SnapshotService_GetChannelGraph_Handler,
+		},
+		{
+			MethodName: "GetSyncWaiters",
+			Handler:    _SnapshotService_GetSyncWaiters_Handler,
+		},
+		{
+			MethodName: "GetNetConnections",
+			Handler:    _SnapshotService_GetNetConnections_Handler,
+		},
+		{
+			MethodName: "GetRuntimeInfo",
+			Handler:    _SnapshotService_GetRuntimeInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+const (
+	ScriptService_RegisterScript_FullMethodName        = "/agentrpc.ScriptService/RegisterScript"
+	ScriptService_UnregisterScript_FullMethodName      = "/agentrpc.ScriptService/UnregisterScript"
+	ScriptService_ListRegisteredScripts_FullMethodName = "/agentrpc.ScriptService/ListRegisteredScripts"
+	ScriptService_ExecScript_FullMethodName            = "/agentrpc.ScriptService/ExecScript"
+)
+
+// ScriptServiceClient is the client API for ScriptService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScriptServiceClient interface {
+	// RegisterScript registers a named script.
+	RegisterScript(ctx context.Context, in *RegisterScriptIn, opts ...grpc.CallOption) (*RegisterScriptOut, error)
+	// UnregisterScript removes a registered script.
+	UnregisterScript(ctx context.Context, in *UnregisterScriptIn, opts ...grpc.CallOption) (*UnregisterScriptOut, error)
+	// ListRegisteredScripts returns the registered scripts.
+	ListRegisteredScripts(ctx context.Context, in *ListRegisteredScriptsIn, opts ...grpc.CallOption) (*ListRegisteredScriptsOut, error)
+	// ExecScript runs a registered script with the given arguments. The target
+	// is halted while the script runs.
+	ExecScript(ctx context.Context, in *ExecScriptIn, opts ...grpc.CallOption) (*ExecScriptOut, error)
+}
+
+type scriptServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScriptServiceClient(cc grpc.ClientConnInterface) ScriptServiceClient {
+	return &scriptServiceClient{cc}
+}
+
+func (c *scriptServiceClient) RegisterScript(ctx context.Context, in *RegisterScriptIn, opts ...grpc.CallOption) (*RegisterScriptOut, error) {
+	out := new(RegisterScriptOut)
+	err := c.cc.Invoke(ctx, ScriptService_RegisterScript_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scriptServiceClient) UnregisterScript(ctx context.Context, in *UnregisterScriptIn, opts ...grpc.CallOption) (*UnregisterScriptOut, error) {
+	out := new(UnregisterScriptOut)
+	err := c.cc.Invoke(ctx, ScriptService_UnregisterScript_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scriptServiceClient) ListRegisteredScripts(ctx context.Context, in *ListRegisteredScriptsIn, opts ...grpc.CallOption) (*ListRegisteredScriptsOut, error) {
+	out := new(ListRegisteredScriptsOut)
+	err := c.cc.Invoke(ctx, ScriptService_ListRegisteredScripts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scriptServiceClient) ExecScript(ctx context.Context, in *ExecScriptIn, opts ...grpc.CallOption) (*ExecScriptOut, error) {
+	out := new(ExecScriptOut)
+	err := c.cc.Invoke(ctx, ScriptService_ExecScript_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScriptServiceServer is the server API for ScriptService service.
+// All implementations must embed UnimplementedScriptServiceServer
+// for forward compatibility
+type ScriptServiceServer interface {
+	// RegisterScript registers a named script.
+	RegisterScript(context.Context, *RegisterScriptIn) (*RegisterScriptOut, error)
+	// UnregisterScript removes a registered script.
+	UnregisterScript(context.Context, *UnregisterScriptIn) (*UnregisterScriptOut, error)
+	// ListRegisteredScripts returns the registered scripts.
+	ListRegisteredScripts(context.Context, *ListRegisteredScriptsIn) (*ListRegisteredScriptsOut, error)
+	// ExecScript runs a registered script with the given arguments. The target
+	// is halted while the script runs.
+	ExecScript(context.Context, *ExecScriptIn) (*ExecScriptOut, error)
+	mustEmbedUnimplementedScriptServiceServer()
+}
+
+// UnimplementedScriptServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedScriptServiceServer struct {
+}
+
+func (UnimplementedScriptServiceServer) RegisterScript(context.Context, *RegisterScriptIn) (*RegisterScriptOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterScript not implemented")
+}
+func (UnimplementedScriptServiceServer) UnregisterScript(context.Context, *UnregisterScriptIn) (*UnregisterScriptOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterScript not implemented")
+}
+func (UnimplementedScriptServiceServer) ListRegisteredScripts(context.Context, *ListRegisteredScriptsIn) (*ListRegisteredScriptsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegisteredScripts not implemented")
+}
+func (UnimplementedScriptServiceServer) ExecScript(context.Context, *ExecScriptIn) (*ExecScriptOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecScript not implemented")
+}
+func (UnimplementedScriptServiceServer) mustEmbedUnimplementedScriptServiceServer() {}
+
+// UnsafeScriptServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScriptServiceServer will
+// result in compilation errors.
+type UnsafeScriptServiceServer interface {
+	mustEmbedUnimplementedScriptServiceServer()
+}
+
+func RegisterScriptServiceServer(s grpc.ServiceRegistrar, srv ScriptServiceServer) {
+	s.RegisterService(&ScriptService_ServiceDesc, srv)
+}
+
+func _ScriptService_RegisterScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterScriptIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScriptServiceServer).RegisterScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScriptService_RegisterScript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScriptServiceServer).RegisterScript(ctx, req.(*RegisterScriptIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScriptService_UnregisterScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterScriptIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScriptServiceServer).UnregisterScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScriptService_UnregisterScript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScriptServiceServer).UnregisterScript(ctx, req.(*UnregisterScriptIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScriptService_ListRegisteredScripts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegisteredScriptsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScriptServiceServer).ListRegisteredScripts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScriptService_ListRegisteredScripts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScriptServiceServer).ListRegisteredScripts(ctx, req.(*ListRegisteredScriptsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScriptService_ExecScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecScriptIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScriptServiceServer).ExecScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScriptService_ExecScript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScriptServiceServer).ExecScript(ctx, req.(*ExecScriptIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScriptService_ServiceDesc is the grpc.ServiceDesc for ScriptService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScriptService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "agentrpc.ScriptService",
+	HandlerType: (*ScriptServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterScript",
+			Handler:    _ScriptService_RegisterScript_Handler,
+		},
+		{
+			MethodName: "UnregisterScript",
+			Handler:    _ScriptService_UnregisterScript_Handler,
+		},
+		{
+			MethodName: "ListRegisteredScripts",
+			Handler:    _ScriptService_ListRegisteredScripts_Handler,
+		},
+		{
+			MethodName: "ExecScript",
+			Handler:    _ScriptService_ExecScript_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
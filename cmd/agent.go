@@ -3,21 +3,26 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"github.com/google/pprof/profile"
 	"github.com/kr/pretty"
 	pp "github.com/maruel/panicparse/v2/stack"
+	"go/token"
 	"google.golang.org/grpc"
 	"io"
 	"log"
 	"net"
-	"os"
+	"net/rpc"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/andreimatei/delve-agent/agentrpc"
+	"github.com/go-delve/delve/service/api"
 	"github.com/go-delve/delve/service/rpc2"
 )
 
@@ -25,10 +30,28 @@ var delveAddrFlag = flag.String("addr", "127.0.0.1:45689", "")
 var grpclistenAddrFlag = flag.String("listen-grpc", "127.0.0.1:1235", "")
 var oneShot = flag.Bool("oneshot", false, "")
 
+const (
+	// defaultStackDepth is the maximum number of frames collected for each
+	// goroutine by GetSnapshot, unless the request specifies otherwise.
+	defaultStackDepth = 200
+	// maxStackDepth is the upper bound for the stack depth that GetSnapshot
+	// requests can ask for.
+	maxStackDepth = 10000
+	// truncationCountDepth is the depth up to which stacks deeper than the
+	// requested depth are walked in order to count the truncated frames.
+	truncationCountDepth = 2 * maxStackDepth
+)
+
 type grpcServer struct {
 	agentrpc.UnsafeDebugInfoServer
 	agentrpc.UnsafeSnapshotServiceServer
+	agentrpc.UnsafeScriptServiceServer
 	client *rpc2.RPCClient
+	// halter is used to halt the target while requests inspect it.
+	halter  *haltCoordinator
+	scripts scriptLibrary
+	// collectors are the scripts registered through the ScriptService.
+	collectors *collectorRegistry
 }
 
 func (s *grpcServer) DownloadBinary(ctx context.Context, in *agentrpc.DownloadBinaryIn) (*agentrpc.DownloadBinaryOut, error) {
@@ -43,25 +66,14 @@ func (s *grpcServer) ListProcesses(in *agentrpc.ListProcessesIn, server agentrpc
 
 var _ agentrpc.DebugInfoServer = &grpcServer{}
 var _ agentrpc.SnapshotServiceServer = &grpcServer{}
+var _ agentrpc.ScriptServiceServer = &grpcServer{}
 
 func (s *grpcServer) haltTarget() (resume func()) {
-	_ /* state */, err := s.client.Halt()
+	resume, err := s.halter.halt()
 	if err != nil {
 		panic(err)
 	}
-	return s.continueTarget
-}
-
-func (s *grpcServer) continueTarget() {
-	// Continue blocks, so we do it on a different goroutine that leaks.
-	go func() {
-		ch := s.client.Continue()
-		_ = ch
-		//for state := range ch {
-		//	log.Printf("got state: %s", pretty.Sprint(state))
-		//}
-		//log.Print("finished with continue; channel closed")
-	}()
+	return resume
 }
 
 func (s *grpcServer) ListFunctions(ctx context.Context, args *agentrpc.ListFunctionsIn) (*agentrpc.ListFunctionsOut, error) {
@@ -218,25 +230,142 @@ func (s *grpcServer) ListVars(ctx context.Context, in *agentrpc.ListVarsIn) (*ag
 //	return nil
 //}
 
-func scriptResultsToPProf(stacks map[int]string) (*profile.Profile, error) {
+// parseStacks parses the stacks produced by walk_stacks.star.
+func parseStacks(stacks map[int]string) (*pp.Snapshot, error) {
 	stacksStr := stacksToString(stacks)
-	// Parse the stacks.
 	opts := pp.DefaultOpts()
 	opts.ParsePC = true
 	snap, _, err := pp.ScanSnapshot(strings.NewReader(stacksStr), io.Discard, opts)
 	if err != io.EOF {
 		return nil, fmt.Errorf("failed to scan stacks: %w", err)
 	}
-	agg := snap.Aggregate(pp.AnyValue)
-	b := newPProfBuilder()
+	return snap, nil
+}
+
+// symbolizeUnknownFrames names the frames for which Delve doesn't have a
+// function, using sym to find the ELF symbols containing their PCs. Frames that
+// can't be symbolized (or all of them, if sym is nil) are named
+// "<unknown pc 0x...>". unknownPCs maps from goroutine ID to the PCs of the
+// goroutine's frames that need to be symbolized, keyed by frame index.
+//
+// Returns the entry addresses of the functions that these frames were
+// attributed to, keyed by the functions' complete names.
+func symbolizeUnknownFrames(
+	snap *pp.Snapshot, unknownPCs map[int]map[int]uint64, sym *elfSymbolizer,
+) map[string]uint64 {
+	funcAddrs := make(map[string]uint64)
+	for _, g := range snap.Goroutines {
+		for frameIdx, pc := range unknownPCs[g.ID] {
+			if frameIdx >= len(g.Stack.Calls) {
+				continue
+			}
+			call := &g.Stack.Calls[frameIdx]
+			name, entry, ok := "", pc, false
+			if sym != nil {
+				name, entry, ok = sym.lookup(pc)
+			}
+			if !ok {
+				name, entry = fmt.Sprintf("<unknown pc 0x%x>", pc), pc
+			}
+			if err := call.Func.Init(name); err != nil {
+				call.Func = pp.Func{Complete: name, Name: name}
+			}
+			call.PCOffset = int64(pc - entry)
+			funcAddrs[call.Func.Complete] = entry
+		}
+	}
+	return funcAddrs
+}
+
+// addTruncationMarkers adds a synthetic root frame to the stacks that were
+// truncated, indicating how many frames were dropped.
+func addTruncationMarkers(snap *pp.Snapshot, truncated map[int]truncationInfo) {
+	for _, g := range snap.Goroutines {
+		info, ok := truncated[g.ID]
+		if !ok {
+			continue
+		}
+		plus := ""
+		if info.AtLeast {
+			plus = "+"
+		}
+		name := fmt.Sprintf("<truncated %d%s frames>", info.Frames, plus)
+		g.Stack.Calls = append(g.Stack.Calls, pp.Call{
+			Func: pp.Func{Complete: name, Name: name},
+		})
+	}
+}
+
+// scriptResultsToPProf builds a profile out of the goroutines in snap.
+// funcAddrs contains the entry addresses of functions that Delve doesn't have
+// DWARF info for, keyed by their complete names; the locations in these
+// functions are given their real addresses. labels contains the labels to
+// attach to the goroutines' samples, keyed by goroutine ID. mode controls
+// which goroutines share a sample.
+func scriptResultsToPProf(
+	snap *pp.Snapshot,
+	funcAddrs map[string]uint64,
+	labels map[int]map[string]string,
+	mode agentrpc.GetSnapshotIn_Aggregation,
+) (*profile.Profile, error) {
+	buckets, err := aggregateGoroutines(snap, mode)
+	if err != nil {
+		return nil, err
+	}
+	b := newPProfBuilder(funcAddrs)
+	labels = truncateLabels(labels)
 
-	for _, group := range agg.Buckets {
-		b.addSample(group.Signature.Stack.Calls, group.IDs)
+	for _, group := range buckets {
+		// Goroutines with identical stacks but different labels get different
+		// samples.
+		var keys []string
+		byLabels := make(map[string][]int)
+		for _, gID := range group.IDs {
+			k := labelsKey(labels[gID])
+			if _, ok := byLabels[k]; !ok {
+				keys = append(keys, k)
+			}
+			byLabels[k] = append(byLabels[k], gID)
+		}
+		for _, k := range keys {
+			gIDs := byLabels[k]
+			b.addSample(group.Signature.Stack.Calls, gIDs, labels[gIDs[0]])
+		}
 	}
 	b.profile.TimeNanos = time.Now().UnixNano()
 	return b.profile, nil
 }
 
+// aggregateGoroutines groups the goroutines in snap into buckets according to
+// the aggregation mode. With the NONE mode, every goroutine gets its own
+// bucket, ordered by goroutine ID.
+func aggregateGoroutines(
+	snap *pp.Snapshot, mode agentrpc.GetSnapshotIn_Aggregation,
+) ([]*pp.Bucket, error) {
+	var similarity pp.Similarity
+	switch mode {
+	case agentrpc.GetSnapshotIn_SIMILAR_ANY_VALUE:
+		similarity = pp.AnyValue
+	case agentrpc.GetSnapshotIn_SIMILAR_ANY_POINTER:
+		similarity = pp.AnyPointer
+	case agentrpc.GetSnapshotIn_EXACT:
+		similarity = pp.ExactFlags
+	case agentrpc.GetSnapshotIn_NONE:
+		goroutines := append([]*pp.Goroutine(nil), snap.Goroutines...)
+		sort.Slice(goroutines, func(i, j int) bool {
+			return goroutines[i].ID < goroutines[j].ID
+		})
+		buckets := make([]*pp.Bucket, len(goroutines))
+		for i, g := range goroutines {
+			buckets[i] = &pp.Bucket{Signature: g.Signature, IDs: []int{g.ID}}
+		}
+		return buckets, nil
+	default:
+		return nil, fmt.Errorf("unsupported aggregation mode %s", mode)
+	}
+	return snap.Aggregate(similarity).Buckets, nil
+}
+
 //type Server struct {
 //	client *rpc2.RPCClient
 //}
@@ -347,86 +476,299 @@ func scriptResultsToPProf(stacks map[int]string) (*profile.Profile, error) {
 type CapturedExpr struct {
 	Expr string
 	Val  string
+	// Structured is the structured representation of the value.
+	Structured *capturedValue
+	// Err is set if evaluating the expression failed. In that case, Val and
+	// Structured are not set.
+	Err string
+}
+
+// rawValue returns the captured value without the formatting of Val: strings
+// are not quoted and don't carry a note about their truncation. truncated is
+// set if a string was cut short when it was read from the target, in which
+// case the returned value is a prefix of the actual string. Composite values
+// are returned like Val, which notes their truncation itself.
+func (c *CapturedExpr) rawValue() (v string, truncated bool) {
+	if c.Structured == nil {
+		return c.Val, false
+	}
+	if c.Structured.Kind == reflect.String.String() || c.Structured.Value != "" {
+		return c.Structured.Value, c.Structured.Truncated
+	}
+	return c.Val, false
+}
+
+// capturedValue is the serialization of a Delve api.Variable; see
+// newCapturedValue. It mirrors agentrpc.Value.
+type capturedValue struct {
+	Name        string
+	Kind        string
+	Type        string
+	Value       string
+	Addr        uint64
+	PointerAddr uint64
+	Len         int64
+	Cap         int64
+	Truncated   bool
+	Unreadable  string
+	Children    []*capturedValue
+}
+
+func (v *capturedValue) toProto() *agentrpc.Value {
+	if v == nil {
+		return nil
+	}
+	out := &agentrpc.Value{
+		Name:             v.Name,
+		Kind:             v.Kind,
+		Type:             v.Type,
+		Value:            v.Value,
+		Address:          v.Addr,
+		PointerAddress:   v.PointerAddr,
+		Len:              v.Len,
+		Cap:              v.Cap,
+		Truncated:        v.Truncated,
+		Unreadable:       v.Unreadable != "",
+		UnreadableReason: v.Unreadable,
+	}
+	if len(v.Children) > 0 {
+		out.Children = make([]*agentrpc.Value, len(v.Children))
+		for i, c := range v.Children {
+			out.Children[i] = c.toProto()
+		}
+	}
+	return out
+}
+
+// newCapturedValue converts a variable read from the target into a
+// capturedValue. Only the children that were loaded according to the load
+// config used for reading v are converted.
+//
+// If reload is not nil, it is called for v and for all its descendants; if it
+// returns a variable, that variable is converted instead of the original one.
+// Values nested inside a reloaded value are not reloaded again, so that
+// recursive types don't cause unbounded reloading.
+func newCapturedValue(
+	v *api.Variable, reload func(*api.Variable) (*api.Variable, error),
+) (*capturedValue, error) {
+	name := v.Name
+	if reload != nil {
+		r, err := reload(v)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			v = r
+			reload = nil
+		}
+	}
+	out := &capturedValue{
+		Name:       name,
+		Kind:       v.Kind.String(),
+		Type:       v.Type,
+		Addr:       v.Addr,
+		Len:        v.Len,
+		Cap:        v.Cap,
+		Truncated:  v.OnlyAddr,
+		Unreadable: v.Unreadable,
+	}
+	if v.Unreadable != "" {
+		return out, nil
+	}
+	addChild := func(c *api.Variable, name string) error {
+		child, err := newCapturedValue(c, reload)
+		if err != nil {
+			return err
+		}
+		if name != "" {
+			child.Name = name
+		}
+		out.Children = append(out.Children, child)
+		return nil
+	}
+	switch v.Kind {
+	case reflect.String:
+		out.Value = v.Value
+		// Len is the length of the string in the target, whereas Value might
+		// have been cut at MaxStringLen.
+		if int64(len(v.Value)) < v.Len {
+			out.Truncated = true
+		}
+		return out, nil
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.UnsafePointer, reflect.Func:
+		out.Value = v.SinglelineString()
+		return out, nil
+	case reflect.Ptr:
+		if len(v.Children) > 0 {
+			out.PointerAddr = v.Children[0].Addr
+			if v.Children[0].Addr != 0 {
+				if err := addChild(&v.Children[0], ""); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case reflect.Map:
+		// Delve represents maps as a flat list of alternating keys and values.
+		for i := 0; i+1 < len(v.Children); i += 2 {
+			k := &v.Children[i]
+			name := k.Value
+			if k.Kind != reflect.String {
+				name = k.SinglelineString()
+			}
+			if err := addChild(&v.Children[i+1], name); err != nil {
+				return nil, err
+			}
+		}
+		if int64(len(out.Children)) < v.Len {
+			out.Truncated = true
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Children {
+			if err := addChild(&v.Children[i], fmt.Sprintf("[%d]", i)); err != nil {
+				return nil, err
+			}
+		}
+		if int64(len(out.Children)) < v.Len {
+			out.Truncated = true
+		}
+	default:
+		// Structs, interfaces, channels.
+		for i := range v.Children {
+			if err := addChild(&v.Children[i], ""); err != nil {
+				return nil, err
+			}
+		}
+		if v.Kind == reflect.Struct && int64(len(out.Children)) < v.Len {
+			out.Truncated = true
+		}
+	}
+	return out, nil
 }
 
 // scriptResults is the result of running the walk_stacks.star script.
 type scriptResults struct {
 	Stacks map[int]string `json:"stacks"`
-	// Map from goroutine ID to map from frame index to array of captured values.
-	// The frame indexes match the order in Stacks - from leaf function to
-	// callers.
-	FramesOfInterest map[int]map[int][]CapturedExpr `json:"frames_of_interest"`
+	// FrameIndexes maps from goroutine ID to, for each frame in Stacks, the
+	// index of the frame in Delve's stack trace. The two indexes differ because
+	// some frames are not included in Stacks.
+	FrameIndexes map[int][]int `json:"frame_indexes"`
+	// Truncated contains information about the stacks that were truncated
+	// because they were deeper than the requested depth, keyed by goroutine ID.
+	Truncated map[int]truncationInfo `json:"truncated"`
+	// UnknownPCs maps from goroutine ID to, for each frame in Stacks that Delve
+	// does not have a function for, the frame's PC. The frames are keyed by
+	// their index in Stacks.
+	UnknownPCs map[int]map[int]uint64 `json:"unknown_pcs"`
+	// FrameArgs maps from goroutine ID to, for each frame in Stacks that has
+	// arguments that the context extractors are looking for, these arguments.
+	// The frames are keyed by their index in Stacks.
+	FrameArgs map[int]map[int][]frameArg `json:"frame_args"`
+	// ParentIDs maps from goroutine ID to the ID of the goroutine's parent. Only
+	// populated if requested.
+	ParentIDs map[int]int64 `json:"parent_ids"`
+}
+
+// truncationInfo describes the frames that were dropped from a goroutine's
+// stack.
+type truncationInfo struct {
+	// Frames is the number of frames that were dropped.
+	Frames int
+	// AtLeast is set if the stack was too deep for all the frames to be
+	// counted, in which case Frames is a lower bound.
+	AtLeast bool
+}
+
+// evalTask identifies an expression to be evaluated in the scope of a frame of
+// interest; see evalExprs.
+type evalTask struct {
+	GoroutineID int `json:"gid"`
+	// FrameIdx is the index of the frame in Delve's stack trace. It is used for
+	// evaluating the expression.
+	FrameIdx int `json:"frame_index"`
+	// OutputFrameIdx is the index of the frame in the stack included in the
+	// snapshot.
+	OutputFrameIdx int    `json:"output_frame_index"`
+	FuncName       string `json:"function_of_interest"`
+	// Extractor is the name of the context extractor that the task belongs to,
+	// if any. Tasks belong either to a frame spec or to an extractor.
+	Extractor  string     `json:"-"`
+	Expr       string     `json:"expr"`
+	LoadConfig loadConfig `json:"load_config"`
 }
 
 // GetSnapshot collects the stack traces of all the goroutines and the requested
 // data for the specified frames of interest.
 func (s *grpcServer) GetSnapshot(ctx context.Context, in *agentrpc.GetSnapshotIn) (*agentrpc.GetSnapshotOut, error) {
-	log.Printf("!!! GetSnapshot...")
+	matchers, err := newFrameMatchers(in.FrameSpecs)
+	if err != nil {
+		return nil, err
+	}
+	extractors, err := newContextExtractors(in.ContextExtractors)
+	if err != nil {
+		return nil, err
+	}
+
 	// Halt the target and defer the resumption.
 	defer s.haltTarget()()
 
-	starScript, err := os.ReadFile("walk_stacks.star")
+	gs, err := s.listGoroutines()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
-
-	// Parameterize the script with the frames of interest.
-	var sb strings.Builder
-	for _, frameSpec := range in.FrameSpecs {
-		sb.WriteString(fmt.Sprintf("'%s': [", frameSpec.FuncName))
-		for i, expr := range frameSpec.Expressions {
-			if i > 0 {
-				sb.WriteString(", ")
-			}
-			sb.WriteString(fmt.Sprintf("'%s'", expr))
-		}
-		sb.WriteString("],\n")
+	walk, err := s.walkStacks(walkOptions{
+		maxDepth:   in.MaxStackDepth,
+		extractors: extractors,
+		parentIDs:  len(gs) > 0 && s.tracksParentGoroutines(gs[0]),
+		// The pprof labels are only needed for grouping and labeling the
+		// samples by them.
+		labels: len(in.GroupBy) > 0 || len(in.LabelExpressions) > 0,
+	})
+	if err != nil {
+		return nil, err
 	}
-	// Run the script.
-	script := strings.Replace(string(starScript), "$frames_spec", sb.String(), 1)
-	typeSpecs := typeSpecsToStarlark(in.TypeSpecs)
-	log.Printf("typeSpecs: %s", typeSpecs)
-	script = strings.Replace(script, "$type_specs", typeSpecsToStarlark(in.TypeSpecs), 1)
+	stacks := walk.stacks
 
-	scriptRes, err := s.client.ExecScript(script)
+	// Find the frames of interest and evaluate their expressions.
+	evalTasks := frameSpecTasks(matchers, stacks, walk.FrameIndexes)
+	evalTasks = append(evalTasks, extractorTasks(extractors, stacks, walk.FrameArgs, walk.FrameIndexes)...)
+	captured, err := s.evalExprs(evalTasks, in.TypeSpecs)
 	if err != nil {
-		log.Printf("script failed: %v\nOutput:%s", err, scriptRes.Output)
-		return nil, fmt.Errorf("executing script failed: %w\nOutput:%s", err, scriptRes.Output)
+		return nil, err
 	}
+	framesOfInterest := groupCaptured(evalTasks, captured)
 
-	log.Printf("!!! script output: %s", scriptRes.Output)
-
-	unquoted, err := strconv.Unquote(scriptRes.Val)
-	if err != nil {
-		panic(err)
+	// The values of the context extractors, of the group_by expressions and of
+	// the label expressions become labels.
+	labelNames := make(map[string]struct{})
+	for _, ext := range extractors {
+		labelNames[ext.Name] = struct{}{}
 	}
-	// Unmarshal the script results.
-	var snap scriptResults
-	err = json.Unmarshal([]byte(unquoted), &snap)
-	if err != nil {
-		log.Printf("%v. failed to decode: %s", err, unquoted)
-		panic(err)
+	for _, expr := range in.GroupBy {
+		labelNames[expr] = struct{}{}
 	}
-	profile, err := scriptResultsToPProf(snap.Stacks)
+	for _, expr := range in.LabelExpressions {
+		labelNames[expr] = struct{}{}
+	}
+	labels := capturedLabels(evalTasks, captured, labelNames)
+	addPProfLabels(labels, walk.labels)
+	groups := groupGoroutines(stacks, labels, in.GroupBy)
+
+	// Now that the frames of interest have been identified, mark the truncated
+	// stacks and build the profile.
+	addTruncationMarkers(stacks, walk.Truncated)
+	profile, err := scriptResultsToPProf(stacks, walk.funcAddrs, labels, in.Aggregation)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse script results: %w", err)
 	}
 
 	var frameData []*agentrpc.FrameData
-	for gid, fois := range snap.FramesOfInterest {
+	for gid, fois := range framesOfInterest {
 		for frameIdx, capturedExprs := range fois {
-			var data []*agentrpc.CapturedExpression
-			for _, v := range capturedExprs {
-				data = append(data, &agentrpc.CapturedExpression{
-					Expression: v.Expr,
-					Value:      v.Val,
-				})
-			}
-			frameData = append(frameData, &agentrpc.FrameData{
-				GoroutineId:   int64(gid),
-				FrameIdx:      int64(frameIdx),
-				CapturedExprs: data,
-			})
+			frameData = append(frameData, frameDataToProto(gid, frameIdx, capturedExprs))
 		}
 	}
 
@@ -437,43 +779,431 @@ func (s *grpcServer) GetSnapshot(ctx context.Context, in *agentrpc.GetSnapshotIn
 	//}
 
 	return &agentrpc.GetSnapshotOut{
-		Profile:   profileToProto(profile),
-		FrameData: frameData,
+		Profile:                profileToProto(profile),
+		FrameData:              frameData,
+		ExpressionSummaries:    summarizeEvals(evalTasks, captured),
+		NumTruncatedGoroutines: int32(len(walk.Truncated)),
+		NumLabelReadFailures:   int32(walk.labelFailures),
+		Scripts:                []*agentrpc.ScriptInfo{walk.script.info()},
+		Groups:                 groups,
+		Goroutines:             s.goroutineInfos(gs, walk.ParentIDs, walk.labels),
 		// !!!
 		//FlightRecorderData: frData.Data,
 	}, nil
 }
 
-func typeSpecsToStarlark(specs []*agentrpc.TypeSpec) string {
-	// Generate a starlark list looking list this:
-	//	typeSpecs := `[
-	//	{
-	//	"TypeName": "github.com/cockroachdb/cockroach/pkg/kv/kvpb.PutRequest",
-	//	"CollectAll: false,
-	//	"LoadSpec": {"Exprs": ["Value", "Inline", "Blind"]},
-	//	},
-	//  ...
-	//]`
+// stackWalk is the result of walkStacks.
+type stackWalk struct {
+	scriptResults
+	// stacks are the parsed stacks.
+	stacks *pp.Snapshot
+	// funcAddrs contains the entry addresses of the functions that frames
+	// without DWARF info were attributed to; see symbolizeUnknownFrames.
+	funcAddrs map[string]uint64
+	// script is the script that was run.
+	script *script
+	// labels maps from goroutine ID to the goroutine's pprof labels, for the
+	// goroutines that have any. Only populated if requested.
+	labels map[int]map[string]string
+	// labelFailures is the number of goroutines whose pprof labels could not
+	// be read.
+	labelFailures int
+}
 
-	var sb strings.Builder
-	sb.WriteString("[\n")
-	for _, spec := range specs {
-		sb.WriteString("{\n")
-		sb.WriteString(fmt.Sprintf("\t\"TypeName\": \"%s\",\n", spec.TypeName))
-		collectAllStr := "False"
-		if spec.CollectAll {
-			collectAllStr = "True"
-		}
-		sb.WriteString(fmt.Sprintf("\t\"LoadSpec\": {\"CollectAll\": %s, \"Exprs\": [", collectAllStr))
-		for i, expr := range spec.Expressions {
-			if i > 0 {
-				sb.WriteString(", ")
+// walkOptions controls what walkStacks collects besides the stacks.
+type walkOptions struct {
+	// maxDepth is the requested maximum stack depth; 0 means the default.
+	maxDepth   int32
+	extractors []*contextExtractor
+	// parentIDs, if set, requests the IDs of the goroutines' parents. The
+	// target's runtime needs to track them; see tracksParentGoroutines.
+	parentIDs bool
+	// labels, if set, requests the goroutines' pprof labels.
+	labels bool
+}
+
+// walkStacks runs walk_stacks.star in order to collect the stacks of all the
+// goroutines, and parses them. The target needs to be halted.
+func (s *grpcServer) walkStacks(opts walkOptions) (*stackWalk, error) {
+	walkScript, err := s.scripts.get(walkStacksScript)
+	if err != nil {
+		return nil, err
+	}
+
+	stackDepth := int(opts.maxDepth)
+	if stackDepth <= 0 {
+		stackDepth = defaultStackDepth
+	} else if stackDepth > maxStackDepth {
+		stackDepth = maxStackDepth
+	}
+	script, err := withParams(walkScript.source, scriptParams{
+		"max_stack_depth":        stackDepth,
+		"truncation_count_depth": truncationCountDepth,
+		"context_args":           contextArgs(opts.extractors),
+		"parent_ids":             opts.parentIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Run the script.
+	scriptRes, err := s.client.ExecScript(script)
+	if err != nil {
+		log.Printf("script failed: %v\nOutput:%s", err, scriptRes.Output)
+		return nil, fmt.Errorf("executing script failed: %w\nOutput:%s", err, scriptRes.Output)
+	}
+
+	unquoted, err := strconv.Unquote(scriptRes.Val)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse script results: %w", err)
+	}
+	// Unmarshal the script results.
+	walk := &stackWalk{script: walkScript}
+	if err := json.Unmarshal([]byte(unquoted), &walk.scriptResults); err != nil {
+		return nil, fmt.Errorf("failed to parse script results: %w", err)
+	}
+	walk.stacks, err = parseStacks(walk.Stacks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse script results: %w", err)
+	}
+	if len(walk.UnknownPCs) > 0 {
+		sym, err := newELFSymbolizer(s.client.ProcessPid())
+		if err != nil {
+			log.Printf("failed to read the target's memory mappings; frames without DWARF info will not be symbolized: %v", err)
+		}
+		walk.funcAddrs = symbolizeUnknownFrames(walk.stacks, walk.UnknownPCs, sym)
+	}
+	if opts.labels {
+		walk.labels, walk.labelFailures, err = s.readPProfLabels(walk.stacks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return walk, nil
+}
+
+// frameSpecTasks returns the tasks for evaluating the expressions of the frame
+// specs in the frames of interest. frameIndexes maps from goroutine ID to the
+// Delve frame indexes of the goroutine's frames; see scriptResults.
+func frameSpecTasks(matchers []*frameMatcher, stacks *pp.Snapshot, frameIndexes map[int][]int) []evalTask {
+	var evalTasks []evalTask
+	for _, g := range stacks.Goroutines {
+		for i := range g.Stack.Calls {
+			for _, m := range matchers {
+				if !m.matches(&g.Stack.Calls[i]) {
+					continue
+				}
+				addTask := func(frameIdx int, expr string, cfg loadConfig) {
+					evalTasks = append(evalTasks, evalTask{
+						GoroutineID:    g.ID,
+						FrameIdx:       frameIndexes[g.ID][frameIdx],
+						OutputFrameIdx: frameIdx,
+						FuncName:       m.spec.FuncName,
+						Expr:           expr,
+						LoadConfig:     cfg,
+					})
+				}
+				for _, expr := range m.spec.Expressions {
+					addTask(i, expr, m.exprCfgs[expr])
+				}
+				for _, e := range m.relExprs {
+					if target, ok := e.targetFrame(g.Stack.Calls, i); ok {
+						addTask(target, e.Expression, e.cfg)
+					}
+				}
+			}
+		}
+	}
+	return evalTasks
+}
+
+// groupCaptured groups the results of the evaluation of tasks by goroutine and
+// frame. It returns a map from goroutine ID to map from frame index to array of
+// captured values. The frame indexes match the order in the stacks - from leaf
+// function to callers.
+//
+// The results of the context extractors' tasks are renamed after the
+// extractors.
+func groupCaptured(tasks []evalTask, captured []CapturedExpr) map[int]map[int][]CapturedExpr {
+	framesOfInterest := make(map[int]map[int][]CapturedExpr)
+	for i, task := range tasks {
+		if task.Extractor != "" {
+			captured[i].Expr = task.Extractor
+		}
+		fois, ok := framesOfInterest[task.GoroutineID]
+		if !ok {
+			fois = make(map[int][]CapturedExpr)
+			framesOfInterest[task.GoroutineID] = fois
+		}
+		fois[task.OutputFrameIdx] = append(fois[task.OutputFrameIdx], captured[i])
+	}
+	return framesOfInterest
+}
+
+func frameDataToProto(gid int, frameIdx int, capturedExprs []CapturedExpr) *agentrpc.FrameData {
+	var data []*agentrpc.CapturedExpression
+	for _, v := range capturedExprs {
+		data = append(data, &agentrpc.CapturedExpression{
+			Expression:      v.Expr,
+			Value:           v.Val,
+			StructuredValue: v.Structured.toProto(),
+			Error:           v.Err,
+		})
+	}
+	return &agentrpc.FrameData{
+		GoroutineId:   int64(gid),
+		FrameIdx:      int64(frameIdx),
+		CapturedExprs: data,
+	}
+}
+
+// ListScripts is part of the SnapshotService interface.
+func (s *grpcServer) ListScripts(
+	ctx context.Context, in *agentrpc.ListScriptsIn,
+) (*agentrpc.ListScriptsOut, error) {
+	return &agentrpc.ListScriptsOut{Scripts: s.scripts.infos()}, nil
+}
+
+// evalExprs evaluates the expressions described by tasks. The results are
+// returned in the order of the tasks. typeSpecs are passed to Delve as known
+// types, and the values whose type has a load config in typeSpecs are loaded
+// again according to that config.
+//
+// In order to limit the number of calls to Delve while the target is halted,
+// the expressions that name a variable of their frame are read together with
+// the frame's other variables; see readFrameVars. The other expressions are
+// evaluated one by one.
+//
+// An expression that fails to evaluate does not prevent the others from being
+// evaluated; the error is recorded in the respective result. Errors that don't
+// come from the evaluation itself, like a broken connection to Delve, are
+// returned. The target needs to be halted.
+func (s *grpcServer) evalExprs(tasks []evalTask, typeSpecs []*agentrpc.TypeSpec) ([]CapturedExpr, error) {
+	known := knownTypes(typeSpecs)
+	typeConfigs := typeLoadConfigs(typeSpecs)
+	vars, err := s.readFrameVars(tasks, known)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]CapturedExpr, len(tasks))
+	for i, task := range tasks {
+		scope := api.EvalScope{GoroutineID: int64(task.GoroutineID), Frame: task.FrameIdx}
+		v := vars[i]
+		var err error
+		if v == nil {
+			v, err = s.client.EvalVariable(scope, task.Expr, task.LoadConfig.toAPI(known))
+		}
+		if err == nil {
+			var structured *capturedValue
+			structured, err = newCapturedValue(v, s.typeReloader(scope, typeConfigs, known))
+			if err == nil {
+				res[i] = CapturedExpr{Expr: task.Expr, Val: v.SinglelineString(), Structured: structured}
+				continue
+			}
+		}
+		if !isEvalError(err) {
+			return nil, fmt.Errorf("failed to evaluate %s: %w", task.Expr, err)
+		}
+		res[i] = CapturedExpr{Expr: task.Expr, Err: err.Error()}
+	}
+	return res, nil
+}
+
+// frameVarsKey identifies a group of tasks whose expressions are read together
+// by readFrameVars.
+type frameVarsKey struct {
+	gid, frameIdx int
+	cfg           loadConfig
+}
+
+// minFrameVarsBatch is the minimum number of expressions naming variables of a
+// frame for which the frame's variables are read together. Reading them takes
+// two calls, one for the local variables and one for the arguments.
+const minFrameVarsBatch = 3
+
+// readFrameVars reads, for the tasks whose expression is the name of a variable
+// of their frame, the respective variable. The variables of a frame are read
+// together, using a call for the local variables and one for the arguments,
+// instead of one call per expression. Frames with fewer than minFrameVarsBatch
+// such expressions are skipped.
+//
+// The returned slice corresponds to tasks; the elements are nil for the tasks
+// whose expression still needs to be evaluated, including the ones naming
+// variables that were not found or are shadowed. The target needs to be
+// halted.
+func (s *grpcServer) readFrameVars(tasks []evalTask, known *[]api.KnownType) ([]*api.Variable, error) {
+	var keys []frameVarsKey
+	batches := make(map[frameVarsKey][]int)
+	for i, task := range tasks {
+		if !token.IsIdentifier(task.Expr) {
+			continue
+		}
+		k := frameVarsKey{gid: task.GoroutineID, frameIdx: task.FrameIdx, cfg: task.LoadConfig}
+		if _, ok := batches[k]; !ok {
+			keys = append(keys, k)
+		}
+		batches[k] = append(batches[k], i)
+	}
+	res := make([]*api.Variable, len(tasks))
+	for _, k := range keys {
+		idxs := batches[k]
+		if len(idxs) < minFrameVarsBatch {
+			continue
+		}
+		scope := api.EvalScope{GoroutineID: int64(k.gid), Frame: k.frameIdx}
+		cfg := k.cfg.toAPI(known)
+		locals, err := s.client.ListLocalVariables(scope, cfg)
+		if err == nil {
+			var args []api.Variable
+			args, err = s.client.ListFunctionArgs(scope, cfg)
+			locals = append(locals, args...)
+		}
+		if err != nil {
+			if !isEvalError(err) {
+				return nil, fmt.Errorf("failed to read the variables of frame %d of goroutine %d: %w", k.frameIdx, k.gid, err)
+			}
+			// Leave the expressions to be evaluated one by one, so that the
+			// errors are recorded for each of them.
+			continue
+		}
+		byName := make(map[string]*api.Variable, len(locals))
+		for j := range locals {
+			if locals[j].Flags&api.VariableShadowed == 0 {
+				byName[locals[j].Name] = &locals[j]
+			}
+		}
+		for _, i := range idxs {
+			res[i] = byName[tasks[i].Expr]
+		}
+	}
+	return res, nil
+}
+
+// isEvalError returns true if err was returned by Delve for a failed
+// evaluation, as opposed to an error communicating with Delve.
+func isEvalError(err error) bool {
+	var serverErr rpc.ServerError
+	return errors.As(err, &serverErr)
+}
+
+// typeReloader returns a function that loads again, in the given scope, the
+// values whose type has a load config in typeConfigs, according to that
+// config. The function returns nil for values that don't need to be loaded
+// again. Returns nil if no type has a load config.
+func (s *grpcServer) typeReloader(
+	scope api.EvalScope, typeConfigs map[string]loadConfig, known *[]api.KnownType,
+) func(*api.Variable) (*api.Variable, error) {
+	if len(typeConfigs) == 0 {
+		return nil
+	}
+	return func(v *api.Variable) (*api.Variable, error) {
+		cfg, ok := typeConfigs[v.Type]
+		if !ok || v.Addr == 0 {
+			return nil, nil
+		}
+		return s.client.EvalVariable(scope, fmt.Sprintf(`(*(*"%s")(%#x))`, v.Type, v.Addr), cfg.toAPI(known))
+	}
+}
+
+// summarizeEvals counts, for every expression, how many times it was evaluated
+// and how many of the evaluations failed. results correspond to tasks.
+func summarizeEvals(tasks []evalTask, results []CapturedExpr) []*agentrpc.ExpressionSummary {
+	type key struct {
+		funcName  string
+		extractor string
+		expr      string
+	}
+	summaries := make(map[key]*agentrpc.ExpressionSummary)
+	var out []*agentrpc.ExpressionSummary
+	for i, task := range tasks {
+		k := key{funcName: task.FuncName, extractor: task.Extractor, expr: task.Expr}
+		summary, ok := summaries[k]
+		if !ok {
+			summary = &agentrpc.ExpressionSummary{
+				FuncName:   task.FuncName,
+				Extractor:  task.Extractor,
+				Expression: task.Expr,
 			}
-			sb.WriteString(fmt.Sprintf("\"%s\"", expr))
+			summaries[k] = summary
+			out = append(out, summary)
 		}
-		sb.WriteString("]},\n}\n")
+		summary.NumEvaluated++
+		if results[i].Err != "" {
+			summary.NumFailed++
+			if summary.FirstError == "" {
+				summary.FirstError = results[i].Err
+			}
+		}
+	}
+	return out
+}
+
+// knownTypes converts type specs into the KnownTypes of Delve's load
+// configuration. Returns nil if there are no specs.
+func knownTypes(specs []*agentrpc.TypeSpec) *[]api.KnownType {
+	if len(specs) == 0 {
+		return nil
+	}
+	res := make([]api.KnownType, len(specs))
+	for i, spec := range specs {
+		res[i].TypeName = spec.TypeName
+		res[i].LoadSpec.CollectAll = spec.CollectAll
+		res[i].LoadSpec.Exprs = append([]string(nil), spec.Expressions...)
+	}
+	return &res
+}
+
+// scriptParams are the parameters passed to a Starlark script, keyed by name.
+// The values need to be serializable to JSON.
+type scriptParams map[string]interface{}
+
+// withParams returns the source of a script that has access to params through
+// the global dict `params`.
+//
+// The parameters are encoded as JSON, which the script decodes, instead of
+// being spliced into the script's source. This way, no parameter value (e.g. an
+// expression containing quotes) can break the script or alter its code.
+func withParams(script string, params scriptParams) (string, error) {
+	paramsJSON, err := json.Marshal(params)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode script parameters: %w", err)
+	}
+	return fmt.Sprintf("params = json.decode(%s)\n%s", strconv.Quote(string(paramsJSON)), script), nil
+}
+
+// maxLabelValueLen is the maximum length of the values of the string labels
+// attached to samples. Longer values (e.g. SQL statements) are truncated.
+const maxLabelValueLen = 200
+
+// truncateLabels returns a copy of labels with the values longer than
+// maxLabelValueLen truncated. Goroutines whose values only differ past the
+// limit end up with the same labels.
+func truncateLabels(labels map[int]map[string]string) map[int]map[string]string {
+	res := make(map[int]map[string]string, len(labels))
+	for gID, gLabels := range labels {
+		truncated := make(map[string]string, len(gLabels))
+		for k, v := range gLabels {
+			if len(v) > maxLabelValueLen {
+				v = strings.ToValidUTF8(v[:maxLabelValueLen], "") + "..."
+			}
+			truncated[k] = v
+		}
+		res[gID] = truncated
+	}
+	return res
+}
+
+// labelsKey returns a string identifying a set of labels.
+func labelsKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "%q=%q,", k, labels[k])
 	}
-	sb.WriteString("]")
 	return sb.String()
 }
 
@@ -531,10 +1261,24 @@ func main() {
 	//	pretty.Print(s)
 	//}
 
+	scripts, err := loadScripts(*scriptsDirFlag)
+	if err != nil {
+		log.Fatalf("failed to load scripts: %v", err)
+	}
+	for _, sc := range scripts.infos() {
+		log.Printf("loaded script %s (version %s) %s", sc.Name, sc.Version, sc.Path)
+	}
+
 	grpcSrv := grpc.NewServer()
-	serverImpl := &grpcServer{client: client}
+	serverImpl := &grpcServer{
+		client:     client,
+		halter:     newHaltCoordinator(client),
+		scripts:    scripts,
+		collectors: newCollectorRegistry(),
+	}
 	agentrpc.RegisterDebugInfoServer(grpcSrv, serverImpl)
 	agentrpc.RegisterSnapshotServiceServer(grpcSrv, serverImpl)
+	agentrpc.RegisterScriptServiceServer(grpcSrv, serverImpl)
 
 	l, e := net.Listen("tcp", *grpclistenAddrFlag)
 	if e != nil {
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// chanWaitFuncs maps the runtime functions that goroutines blocked on channel
+// operations are parked from to the operations. runtime.block is used by
+// selects without cases.
+var chanWaitFuncs = map[string]agentrpc.ChannelWait_Operation{
+	"runtime.chanrecv": agentrpc.ChannelWait_RECEIVE,
+	"runtime.chansend": agentrpc.ChannelWait_SEND,
+	"runtime.selectgo": agentrpc.ChannelWait_SELECT,
+	"runtime.block":    agentrpc.ChannelWait_SELECT,
+}
+
+// maxSelectCases is the maximum number of channels read for a goroutine
+// blocked in a select.
+const maxSelectCases = 256
+
+// chanWaits is the result of chan_waits.star.
+type chanWaits struct {
+	// Waits maps goroutine IDs to the addresses of the channels that the
+	// goroutines wait on.
+	Waits map[int64][]uint64 `json:"Waits"`
+	// Channels maps channel addresses to the channels' state.
+	Channels map[uint64]struct {
+		Len    int64 `json:"Len"`
+		Cap    int64 `json:"Cap"`
+		Closed bool  `json:"Closed"`
+	} `json:"Channels"`
+}
+
+// GetChannelGraph is part of the SnapshotService interface.
+func (s *grpcServer) GetChannelGraph(
+	ctx context.Context, in *agentrpc.GetChannelGraphIn,
+) (*agentrpc.GetChannelGraphOut, error) {
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	walk, err := s.walkStacks(walkOptions{maxDepth: in.MaxStackDepth})
+	if err != nil {
+		return nil, err
+	}
+	out := &agentrpc.GetChannelGraphOut{Waits: channelWaits(walk.stacks)}
+	if len(out.Waits) == 0 {
+		return out, nil
+	}
+	ids := make([]int64, len(out.Waits))
+	for i, w := range out.Waits {
+		ids[i] = w.GoroutineId
+	}
+	cw, err := s.readChannelWaits(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byAddr := make(map[uint64]*agentrpc.ChannelInfo)
+	// waiters maps channel addresses to the IDs of the goroutines waiting on
+	// them.
+	waiters := make(map[uint64]map[int64]struct{})
+	for _, w := range out.Waits {
+		w.Channels = cw.Waits[w.GoroutineId]
+		w.NilChannel = w.Operation != agentrpc.ChannelWait_SELECT && len(w.Channels) == 0
+		for _, addr := range w.Channels {
+			ch, ok := byAddr[addr]
+			if !ok {
+				state := cw.Channels[addr]
+				ch = &agentrpc.ChannelInfo{
+					Address:  addr,
+					Len:      state.Len,
+					Capacity: state.Cap,
+					Closed:   state.Closed,
+				}
+				byAddr[addr] = ch
+				out.Channels = append(out.Channels, ch)
+				waiters[addr] = make(map[int64]struct{})
+			}
+			if _, ok := waiters[addr][w.GoroutineId]; !ok {
+				waiters[addr][w.GoroutineId] = struct{}{}
+				ch.WaiterIds = append(ch.WaiterIds, w.GoroutineId)
+			}
+		}
+	}
+
+	addrs := make([]uint64, len(out.Channels))
+	for i, ch := range out.Channels {
+		addrs[i] = ch.Address
+	}
+	refs, err := s.findReferences(addrs, []string{"runtime.hchan"}, in.MaxStackDepth)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		if _, ok := waiters[ref.Target][int64(ref.GoroutineID)]; ok {
+			continue
+		}
+		ch := byAddr[ref.Target]
+		ch.References = append(ch.References, &agentrpc.FrameReference{
+			GoroutineId: int64(ref.GoroutineID),
+			Frame:       &agentrpc.StackFrame{Function: ref.Function, File: ref.File, Line: ref.Line},
+			FrameIdx:    int32(ref.FrameIdx),
+			Path:        ref.Path,
+		})
+	}
+	for _, ch := range out.Channels {
+		ch.NoCounterpart = len(ch.References) == 0
+	}
+	sort.SliceStable(out.Channels, func(i, j int) bool {
+		return len(out.Channels[i].WaiterIds) > len(out.Channels[j].WaiterIds)
+	})
+
+	out.DeadlockedGoroutineIds = deadlockedGoroutines(out.Waits, byAddr)
+	deadlocked := make(map[int64]struct{}, len(out.DeadlockedGoroutineIds))
+	for _, id := range out.DeadlockedGoroutineIds {
+		deadlocked[id] = struct{}{}
+	}
+	for _, w := range out.Waits {
+		_, w.ProbableDeadlock = deadlocked[w.GoroutineId]
+	}
+
+	if in.RenderJson {
+		js, err := protojson.Marshal(&agentrpc.GetChannelGraphOut{
+			Waits:                  out.Waits,
+			Channels:               out.Channels,
+			DeadlockedGoroutineIds: out.DeadlockedGoroutineIds,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to render the graph as JSON: %w", err)
+		}
+		out.Json = string(js)
+	}
+	if in.RenderDot {
+		out.Dot = channelGraphDOT(out)
+	}
+	return out, nil
+}
+
+// channelWaits returns the goroutines in snap that are blocked on channel
+// operations, ordered by ID. The returned waits don't have their channels
+// populated.
+func channelWaits(snap *pp.Snapshot) []*agentrpc.ChannelWait {
+	var waits []*agentrpc.ChannelWait
+	for _, g := range snap.Goroutines {
+		if !isBlocked(g.State) {
+			continue
+		}
+		calls := g.Stack.Calls
+		for i := range calls {
+			op, ok := chanWaitFuncs[calls[i].Func.Complete]
+			if !ok {
+				continue
+			}
+			w := &agentrpc.ChannelWait{GoroutineId: int64(g.ID), Operation: op}
+			for _, c := range calls[i+1:] {
+				if !strings.HasPrefix(c.Func.Complete, "runtime.") {
+					w.Location = stackToProto([]pp.Call{c})[0]
+					break
+				}
+			}
+			waits = append(waits, w)
+			break
+		}
+	}
+	sort.Slice(waits, func(i, j int) bool {
+		return waits[i].GoroutineId < waits[j].GoroutineId
+	})
+	return waits
+}
+
+// readChannelWaits runs chan_waits.star in order to read the channels that the
+// given goroutines wait on. The target needs to be halted.
+func (s *grpcServer) readChannelWaits(goroutineIDs []int64) (*chanWaits, error) {
+	sc, err := s.scripts.get(chanWaitsScript)
+	if err != nil {
+		return nil, err
+	}
+	script, err := withParams(sc.source, scriptParams{
+		"goroutine_ids": goroutineIDs,
+		"max_cases":     maxSelectCases,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.client.ExecScript(script)
+	if err != nil {
+		return nil, fmt.Errorf("executing script failed: %w\nOutput:%s", err, res.Output)
+	}
+	unquoted, err := strconv.Unquote(res.Val)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse script results: %w", err)
+	}
+	var cw chanWaits
+	if err := json.Unmarshal([]byte(unquoted), &cw); err != nil {
+		return nil, fmt.Errorf("failed to parse script results: %w", err)
+	}
+	return &cw, nil
+}
+
+// deadlockedGoroutines returns the IDs of the goroutines in waits that are
+// probably deadlocked, in increasing order. A goroutine can be unblocked by the
+// goroutines referencing any of the channels it waits on. Starting from all
+// the waiting goroutines, the goroutines that can be unblocked by a goroutine
+// outside of the set are removed until no more can be removed; what remains
+// are the goroutines waiting in cycles, or on channels without counterparts,
+// or on goroutines that are themselves deadlocked.
+func deadlockedGoroutines(
+	waits []*agentrpc.ChannelWait, channels map[uint64]*agentrpc.ChannelInfo,
+) []int64 {
+	stuck := make(map[int64]struct{}, len(waits))
+	for _, w := range waits {
+		stuck[w.GoroutineId] = struct{}{}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, w := range waits {
+			if _, ok := stuck[w.GoroutineId]; !ok {
+				continue
+			}
+			if canBeUnblocked(w, channels, stuck) {
+				delete(stuck, w.GoroutineId)
+				changed = true
+			}
+		}
+	}
+	res := make([]int64, 0, len(stuck))
+	for _, w := range waits {
+		if _, ok := stuck[w.GoroutineId]; ok {
+			res = append(res, w.GoroutineId)
+		}
+	}
+	return res
+}
+
+// canBeUnblocked returns true if a goroutine outside of stuck references any of
+// the channels that w waits on, or if any of the channels is closed.
+func canBeUnblocked(
+	w *agentrpc.ChannelWait, channels map[uint64]*agentrpc.ChannelInfo, stuck map[int64]struct{},
+) bool {
+	for _, addr := range w.Channels {
+		ch := channels[addr]
+		if ch.Closed {
+			return true
+		}
+		for _, ref := range ch.References {
+			if _, ok := stuck[ref.GoroutineId]; !ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// channelGraphDOT renders the wait-for graph in out in the Graphviz DOT
+// language. Goroutines point to the channels they wait on, and channels point
+// to the goroutines that reference them. Probable deadlocks are colored red.
+func channelGraphDOT(out *agentrpc.GetChannelGraphOut) string {
+	var sb strings.Builder
+	sb.WriteString("digraph channels {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	declared := make(map[int64]struct{})
+	for _, w := range out.Waits {
+		lines := []string{fmt.Sprintf("goroutine %d", w.GoroutineId), strings.ToLower(w.Operation.String())}
+		if w.NilChannel {
+			lines[1] += " (nil chan)"
+		}
+		if loc := w.Location; loc != nil {
+			lines = append(lines, loc.Function, fmt.Sprintf("%s:%d", loc.File, loc.Line))
+		}
+		fmt.Fprintf(&sb, "  g%d [shape=box, label=%s%s];\n", w.GoroutineId, dotString(lines...), dotColor(w.ProbableDeadlock))
+		declared[w.GoroutineId] = struct{}{}
+	}
+	for _, ch := range out.Channels {
+		lines := []string{fmt.Sprintf("chan %#x", ch.Address), fmt.Sprintf("len %d, cap %d", ch.Len, ch.Capacity)}
+		if ch.Closed {
+			lines = append(lines, "closed")
+		}
+		fmt.Fprintf(&sb, "  c%x [shape=ellipse, label=%s%s];\n", ch.Address, dotString(lines...), dotColor(ch.NoCounterpart))
+		for _, r := range ch.References {
+			if _, ok := declared[r.GoroutineId]; !ok {
+				fmt.Fprintf(&sb, "  g%d [shape=box, label=%s];\n", r.GoroutineId, dotString(fmt.Sprintf("goroutine %d", r.GoroutineId)))
+				declared[r.GoroutineId] = struct{}{}
+			}
+		}
+	}
+	for _, w := range out.Waits {
+		for _, addr := range w.Channels {
+			fmt.Fprintf(&sb, "  g%d -> c%x;\n", w.GoroutineId, addr)
+		}
+	}
+	for _, ch := range out.Channels {
+		// A goroutine can reference the channel from multiple frames; there's
+		// one edge per goroutine, labeled with the first reference.
+		seen := make(map[int64]struct{})
+		for _, r := range ch.References {
+			if _, ok := seen[r.GoroutineId]; ok {
+				continue
+			}
+			seen[r.GoroutineId] = struct{}{}
+			fmt.Fprintf(&sb, "  c%x -> g%d [style=dashed, label=%s];\n", ch.Address, r.GoroutineId, dotString(r.Path))
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// dotString returns a DOT string literal with the given lines.
+func dotString(lines ...string) string {
+	for i, l := range lines {
+		lines[i] = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(l)
+	}
+	return `"` + strings.Join(lines, `\n`) + `"`
+}
+
+func dotColor(red bool) string {
+	if red {
+		return ", color=red"
+	}
+	return ""
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	"github.com/go-delve/delve/service/rpc2"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultScriptTimeout is the time after which ExecScript gives up waiting
+	// for a script, unless the request specifies otherwise.
+	defaultScriptTimeout = 10 * time.Second
+	// maxScriptTimeout is the upper bound for the timeout that ExecScript
+	// requests can ask for.
+	maxScriptTimeout = 5 * time.Minute
+	// maxScriptResultSize is the maximum size of a script's result. Scripts
+	// producing larger results fail.
+	maxScriptResultSize = 4 << 20
+	// maxScriptOutputSize is the maximum size of a script's print() output
+	// returned by ExecScript. Longer output is truncated.
+	maxScriptOutputSize = 1 << 20
+)
+
+// collector is a script registered through the ScriptService.
+type collector struct {
+	*agentrpc.RegisteredScript
+	// params maps the names of the script's parameters to their declarations.
+	params map[string]*agentrpc.ScriptParam
+}
+
+// collectorRegistry keeps track of the registered scripts.
+type collectorRegistry struct {
+	mu sync.Mutex
+	// collectors maps script names to scripts.
+	collectors map[string]*collector
+}
+
+func newCollectorRegistry() *collectorRegistry {
+	return &collectorRegistry{collectors: make(map[string]*collector)}
+}
+
+// register validates decl and registers it, replacing any script with the same
+// name. Returns the registered script.
+func (r *collectorRegistry) register(decl *agentrpc.RegisteredScript) (*collector, error) {
+	if decl == nil || decl.Name == "" {
+		return nil, fmt.Errorf("script name not specified")
+	}
+	if decl.ResultSchema != "" && !json.Valid([]byte(decl.ResultSchema)) {
+		return nil, fmt.Errorf("result schema of script %s is not valid JSON", decl.Name)
+	}
+	c := &collector{
+		RegisteredScript: proto.Clone(decl).(*agentrpc.RegisteredScript),
+		params:           make(map[string]*agentrpc.ScriptParam, len(decl.Params)),
+	}
+	for _, p := range c.Params {
+		if p.Name == "" {
+			return nil, fmt.Errorf("script %s has a parameter without a name", decl.Name)
+		}
+		if _, ok := c.params[p.Name]; ok {
+			return nil, fmt.Errorf("script %s has duplicate parameter %s", decl.Name, p.Name)
+		}
+		c.params[p.Name] = p
+	}
+	c.Version = newScript(c.Name, []byte(c.Source), "").version
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectors[c.Name] = c
+	return c, nil
+}
+
+func (r *collectorRegistry) unregister(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.collectors[name]; !ok {
+		return fmt.Errorf("script %s not registered", name)
+	}
+	delete(r.collectors, name)
+	return nil
+}
+
+func (r *collectorRegistry) get(name string) (*collector, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.collectors[name]
+	if !ok {
+		return nil, fmt.Errorf("script %s not registered", name)
+	}
+	return c, nil
+}
+
+// list returns the registered scripts, sorted by name.
+func (r *collectorRegistry) list() []*agentrpc.RegisteredScript {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make([]*agentrpc.RegisteredScript, 0, len(r.collectors))
+	for _, c := range r.collectors {
+		res = append(res, c.RegisteredScript)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+// parseArgs checks args against the script's parameters and converts them to
+// the parameters' types.
+func (c *collector) parseArgs(args map[string]string) (scriptParams, error) {
+	params := make(scriptParams, len(args))
+	for name, arg := range args {
+		p, ok := c.params[name]
+		if !ok {
+			return nil, fmt.Errorf("script %s has no parameter %s", c.Name, name)
+		}
+		v, err := parseArg(p.Type, arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for parameter %s of type %s: %w", name, p.Type, err)
+		}
+		params[name] = v
+	}
+	for _, p := range c.Params {
+		if _, ok := args[p.Name]; !ok && p.Required {
+			return nil, fmt.Errorf("missing value for required parameter %s", p.Name)
+		}
+	}
+	return params, nil
+}
+
+func parseArg(typ agentrpc.ScriptParam_Type, arg string) (interface{}, error) {
+	switch typ {
+	case agentrpc.ScriptParam_STRING:
+		return arg, nil
+	case agentrpc.ScriptParam_INT:
+		return strconv.ParseInt(arg, 10, 64)
+	case agentrpc.ScriptParam_FLOAT:
+		return strconv.ParseFloat(arg, 64)
+	case agentrpc.ScriptParam_BOOL:
+		return strconv.ParseBool(arg)
+	case agentrpc.ScriptParam_JSON:
+		if !json.Valid([]byte(arg)) {
+			return nil, fmt.Errorf("not valid JSON")
+		}
+		return json.RawMessage(arg), nil
+	default:
+		return nil, fmt.Errorf("unsupported parameter type")
+	}
+}
+
+// RegisterScript is part of the ScriptService interface.
+func (s *grpcServer) RegisterScript(
+	ctx context.Context, in *agentrpc.RegisterScriptIn,
+) (*agentrpc.RegisterScriptOut, error) {
+	c, err := s.collectors.register(in.Script)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("registered script %s (version %s)", c.Name, c.Version)
+	return &agentrpc.RegisterScriptOut{Version: c.Version}, nil
+}
+
+// UnregisterScript is part of the ScriptService interface.
+func (s *grpcServer) UnregisterScript(
+	ctx context.Context, in *agentrpc.UnregisterScriptIn,
+) (*agentrpc.UnregisterScriptOut, error) {
+	if err := s.collectors.unregister(in.Name); err != nil {
+		return nil, err
+	}
+	return &agentrpc.UnregisterScriptOut{}, nil
+}
+
+// ListRegisteredScripts is part of the ScriptService interface.
+func (s *grpcServer) ListRegisteredScripts(
+	ctx context.Context, in *agentrpc.ListRegisteredScriptsIn,
+) (*agentrpc.ListRegisteredScriptsOut, error) {
+	return &agentrpc.ListRegisteredScriptsOut{Scripts: s.collectors.list()}, nil
+}
+
+// ExecScript is part of the ScriptService interface.
+func (s *grpcServer) ExecScript(
+	ctx context.Context, in *agentrpc.ExecScriptIn,
+) (*agentrpc.ExecScriptOut, error) {
+	c, err := s.collectors.get(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	params, err := c.parseArgs(in.Args)
+	if err != nil {
+		return nil, err
+	}
+	script, err := withParams(c.Source, params)
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.Duration(in.TimeoutMs) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultScriptTimeout
+	} else if timeout > maxScriptTimeout {
+		timeout = maxScriptTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	type result struct {
+		res rpc2.ScriptResult
+		err error
+	}
+	// Delve cannot interrupt a script, so the script runs on a different
+	// goroutine that keeps the target halted until the script finishes, even if
+	// we stop waiting for it.
+	resCh := make(chan result, 1)
+	go func() {
+		defer resume()
+		res, err := s.client.ExecScript(script)
+		resCh <- result{res: res, err: err}
+	}()
+	var res rpc2.ScriptResult
+	select {
+	case r := <-resCh:
+		if r.err != nil {
+			return nil, fmt.Errorf("executing script %s failed: %w\nOutput:%s", c.Name, r.err, r.res.Output)
+		}
+		res = r.res
+	case <-ctx.Done():
+		return nil, fmt.Errorf("script %s did not finish: %w", c.Name, ctx.Err())
+	}
+
+	resultJSON, err := strconv.Unquote(res.Val)
+	if err != nil || !json.Valid([]byte(resultJSON)) {
+		return nil, fmt.Errorf("script %s did not return its result as a JSON string", c.Name)
+	}
+	if len(resultJSON) > maxScriptResultSize {
+		return nil, fmt.Errorf("the result of script %s is too large: %d bytes (max %d)",
+			c.Name, len(resultJSON), maxScriptResultSize)
+	}
+	out := &agentrpc.ExecScriptOut{
+		ResultJson: resultJSON,
+		Output:     res.Output,
+		Version:    c.Version,
+	}
+	if len(out.Output) > maxScriptOutputSize {
+		out.Output = out.Output[:maxScriptOutputSize]
+		out.OutputTruncated = true
+	}
+	return out, nil
+}
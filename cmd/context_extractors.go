@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+// contextExtractor is an agentrpc.ContextExtractor prepared for use.
+type contextExtractor struct {
+	*agentrpc.ContextExtractor
+	// expr is the expression to evaluate.
+	expr string
+	cfg  loadConfig
+}
+
+func newContextExtractors(specs []*agentrpc.ContextExtractor) ([]*contextExtractor, error) {
+	exts := make([]*contextExtractor, len(specs))
+	names := make(map[string]struct{}, len(specs))
+	for i, spec := range specs {
+		if spec.Name == "" || spec.ArgName == "" {
+			return nil, fmt.Errorf("context extractors need both a name and an argument name")
+		}
+		if _, ok := names[spec.Name]; ok {
+			return nil, fmt.Errorf("duplicate context extractor %s", spec.Name)
+		}
+		names[spec.Name] = struct{}{}
+		ext := &contextExtractor{
+			ContextExtractor: spec,
+			expr:             spec.Expression,
+			cfg:              defaultLoadConfig.merge(spec.LoadConfig),
+		}
+		if ext.expr == "" {
+			ext.expr = spec.ArgName
+		}
+		exts[i] = ext
+	}
+	return exts, nil
+}
+
+// contextArgs returns the names of the arguments that the extractors look for.
+func contextArgs(exts []*contextExtractor) []string {
+	args := []string{}
+	seen := make(map[string]struct{})
+	for _, ext := range exts {
+		if _, ok := seen[ext.ArgName]; ok {
+			continue
+		}
+		seen[ext.ArgName] = struct{}{}
+		args = append(args, ext.ArgName)
+	}
+	return args
+}
+
+// frameArg is an argument of a stack frame, as reported by walk_stacks.star.
+type frameArg struct {
+	Name string `json:"Name"`
+	Type string `json:"Type"`
+}
+
+// appliesTo returns true if the extractor applies to a frame with the given
+// arguments.
+func (ext *contextExtractor) appliesTo(args []frameArg) bool {
+	for _, arg := range args {
+		if arg.Name == ext.ArgName && (ext.ArgType == "" || arg.Type == ext.ArgType) {
+			return true
+		}
+	}
+	return false
+}
+
+// extractorTasks returns the tasks for evaluating the extractors' expressions.
+// frameArgs maps from goroutine ID to the arguments of the goroutine's frames,
+// keyed by frame index. For every goroutine, the tasks are ordered from the
+// leaf frame towards the root.
+func extractorTasks(
+	exts []*contextExtractor,
+	snap *pp.Snapshot,
+	frameArgs map[int]map[int][]frameArg,
+	frameIndexes map[int][]int,
+) []evalTask {
+	var tasks []evalTask
+	for _, g := range snap.Goroutines {
+		args := frameArgs[g.ID]
+		if len(args) == 0 {
+			continue
+		}
+		for _, ext := range exts {
+			for i := range g.Stack.Calls {
+				if !ext.appliesTo(args[i]) {
+					continue
+				}
+				tasks = append(tasks, evalTask{
+					GoroutineID:    g.ID,
+					FrameIdx:       frameIndexes[g.ID][i],
+					OutputFrameIdx: i,
+					Extractor:      ext.Name,
+					Expr:           ext.expr,
+					LoadConfig:     ext.cfg,
+				})
+				if !ext.AllFrames {
+					break
+				}
+			}
+		}
+	}
+	return tasks
+}
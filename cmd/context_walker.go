@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	"github.com/go-delve/delve/service/api"
+	"github.com/go-delve/delve/service/rpc2"
+)
+
+// maxContextChainLen is the maximum number of contexts inspected by
+// WalkContext.
+const maxContextChainLen = 100
+
+// contextLoadConfig is the configuration used for reading the contexts in a
+// chain. It reaches deep enough to read the message of an error stored in an
+// interface field of a context (interface -> pointer -> struct -> string).
+var contextLoadConfig = api.LoadConfig{
+	FollowPointers:     true,
+	MaxVariableRecurse: 3,
+	MaxStringLen:       256,
+	MaxArrayValues:     16,
+	MaxStructFields:    32,
+}
+
+// WalkContext is part of the SnapshotService interface.
+func (s *grpcServer) WalkContext(
+	ctx context.Context, in *agentrpc.WalkContextIn,
+) (*agentrpc.WalkContextOut, error) {
+	expr := in.Expression
+	if expr == "" {
+		expr = "ctx"
+	}
+
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	w := contextWalker{
+		client: s.client,
+		scope:  api.EvalScope{GoroutineID: in.GoroutineId, Frame: int(in.FrameIdx)},
+	}
+	return w.walk(expr)
+}
+
+// contextWalker walks a chain of context.Context values in the target. The
+// chain is a linked list: every context implementation in the standard library
+// (and most custom ones) wraps a parent context in a field of type
+// context.Context.
+type contextWalker struct {
+	client *rpc2.RPCClient
+	scope  api.EvalScope
+}
+
+func (w *contextWalker) eval(expr string) (*api.Variable, error) {
+	return w.client.EvalVariable(w.scope, expr, contextLoadConfig)
+}
+
+// walk walks the chain starting at the context that expr evaluates to.
+func (w *contextWalker) walk(expr string) (*agentrpc.WalkContextOut, error) {
+	iface, err := w.eval(expr)
+	if err != nil {
+		return nil, err
+	}
+	if iface.Kind != reflect.Interface {
+		return nil, fmt.Errorf("%s is not an interface; its type is %s", expr, iface.Type)
+	}
+
+	out := &agentrpc.WalkContextOut{}
+	var deadline time.Time
+	// detached is set once the walk passes a context.WithoutCancel() context.
+	// The cancellation and the deadlines of the contexts above it don't
+	// propagate to the walked context; their values still do.
+	detached := false
+	for {
+		data := interfaceData(iface)
+		if data == nil {
+			break
+		}
+		if len(out.Chain) == maxContextChainLen {
+			out.Truncated = true
+			break
+		}
+		node := &agentrpc.ContextNode{Type: data.Type}
+		out.Chain = append(out.Chain, node)
+
+		// Read the context's struct through its address, so that the type is
+		// known regardless of how we got here.
+		typ, addr := data.Type, data.Addr
+		if data.Kind == reflect.Ptr {
+			if len(data.Children) == 0 {
+				node.Error = "pointer not loaded"
+				break
+			}
+			typ, addr = strings.TrimPrefix(data.Type, "*"), data.Children[0].Addr
+		}
+		structExpr := fmt.Sprintf("(*(*%q)(%#x))", typ, addr)
+		v, err := w.eval(structExpr)
+		if err != nil {
+			node.Error = err.Error()
+			break
+		}
+
+		switch typ {
+		case "context.valueCtx":
+			key, val := interfaceData(field(v, "key")), interfaceData(field(v, "val"))
+			kv := &agentrpc.ContextValue{Key: "nil", Value: "nil"}
+			if key != nil {
+				kv.Key, kv.KeyType = key.SinglelineString(), key.Type
+			}
+			if val != nil {
+				kv.Value, kv.ValueType = val.SinglelineString(), val.Type
+			}
+			out.Values = append(out.Values, kv)
+		case "context.timerCtx":
+			if d := field(v, "deadline"); !detached && d != nil {
+				if t, err := timeFromVariable(d); err == nil && (deadline.IsZero() || t.Before(deadline)) {
+					deadline = t
+				}
+			}
+		}
+		// The first cancelled context in the chain determines the cancellation
+		// state; cancellation propagates from parents to children, but not
+		// necessarily synchronously for custom contexts.
+		if !out.Cancelled && !detached {
+			if cc := cancelCtx(typ, v); cc != nil {
+				out.Cancelled, out.Err, out.Cause = cancelState(cc)
+			}
+		}
+		if typ == "context.withoutCancelCtx" {
+			detached = true
+		}
+
+		parentPath, ok := parentContextPath(v, 2 /* maxDepth */)
+		if !ok {
+			break
+		}
+		iface, err = w.eval(structExpr + parentPath)
+		if err != nil {
+			node.Error = fmt.Sprintf("failed to read the parent context: %s", err)
+			break
+		}
+	}
+
+	if !deadline.IsZero() {
+		out.HasDeadline = true
+		out.DeadlineUnixNanos = deadline.UnixNano()
+		out.TimeRemainingNanos = int64(time.Until(deadline))
+	}
+	return out, nil
+}
+
+// interfaceData returns the value stored in the interface v, or nil if v is
+// nil or a nil interface.
+func interfaceData(v *api.Variable) *api.Variable {
+	if v == nil || v.Kind != reflect.Interface || len(v.Children) == 0 {
+		return nil
+	}
+	data := &v.Children[0]
+	if data.Kind == reflect.Invalid && data.Addr == 0 {
+		return nil
+	}
+	return data
+}
+
+// field returns v's field with the given name, or nil if there is no such
+// field.
+func field(v *api.Variable, name string) *api.Variable {
+	if v == nil {
+		return nil
+	}
+	for i := range v.Children {
+		if v.Children[i].Name == name {
+			return &v.Children[i]
+		}
+	}
+	return nil
+}
+
+// cancelCtx returns the context.cancelCtx embedded in the context v of type
+// typ, if any.
+func cancelCtx(typ string, v *api.Variable) *api.Variable {
+	switch typ {
+	case "context.cancelCtx":
+		return v
+	case "context.timerCtx", "context.afterFuncCtx":
+		return field(v, "cancelCtx")
+	default:
+		return nil
+	}
+}
+
+// cancelState returns the cancellation state of a context.cancelCtx.
+func cancelState(cc *api.Variable) (cancelled bool, err, cause string) {
+	errVar := errorField(cc, "err")
+	if errVar == nil {
+		return false, "", ""
+	}
+	if causeVar := errorField(cc, "cause"); causeVar != nil {
+		cause = errorString(causeVar)
+	}
+	return true, errorString(errVar), cause
+}
+
+// errorField returns the value of an error field of v, or nil if the error is
+// nil. Depending on the Go version, the field is either an error or an
+// atomic.Value storing an error.
+func errorField(v *api.Variable, name string) *api.Variable {
+	f := field(v, name)
+	if f != nil && f.Kind == reflect.Struct {
+		f = field(f, "v")
+	}
+	return interfaceData(f)
+}
+
+// errorString renders an error read from the target. Errors are commonly
+// pointers to structs with a message string; the message is returned if there
+// is one.
+func errorString(v *api.Variable) string {
+	s := v
+	if s.Kind == reflect.Ptr && len(s.Children) > 0 {
+		s = &s.Children[0]
+	}
+	if s.Kind == reflect.Struct {
+		for _, name := range []string{"s", "msg"} {
+			if f := field(s, name); f != nil && f.Kind == reflect.String {
+				return f.Value
+			}
+		}
+	}
+	return v.SinglelineString()
+}
+
+// parentContextPath returns the path (e.g. ".cancelCtx.Context") of the field
+// of the context v holding its parent context. Fields of embedded structs are
+// searched up to maxDepth levels deep. Returns false if v does not have a
+// parent.
+func parentContextPath(v *api.Variable, maxDepth int) (string, bool) {
+	for _, f := range v.Children {
+		if f.Type == "context.Context" {
+			return "." + f.Name, true
+		}
+	}
+	if maxDepth == 0 {
+		return "", false
+	}
+	for i := range v.Children {
+		f := &v.Children[i]
+		if f.Kind != reflect.Struct {
+			continue
+		}
+		if path, ok := parentContextPath(f, maxDepth-1); ok {
+			return "." + f.Name + path, true
+		}
+	}
+	return "", false
+}
+
+// timeFromVariable decodes a time.Time read from the target.
+func timeFromVariable(v *api.Variable) (time.Time, error) {
+	wallVar, extVar := field(v, "wall"), field(v, "ext")
+	if wallVar == nil || extVar == nil {
+		return time.Time{}, fmt.Errorf("not a time.Time")
+	}
+	wall, err := strconv.ParseUint(wallVar.Value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	ext, err := strconv.ParseInt(extVar.Value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// See the encoding described in the time.Time type.
+	const (
+		hasMonotonic = 1 << 63
+		nsecShift    = 30
+		nsecMask     = 1<<nsecShift - 1
+		// Seconds from January 1 of year 1 to 1885 and to 1970, respectively.
+		wallToInternal int64 = (1884*365 + 1884/4 - 1884/100 + 1884/400) * 86400
+		unixToInternal int64 = (1969*365 + 1969/4 - 1969/100 + 1969/400) * 86400
+	)
+	nsec := int64(wall & nsecMask)
+	sec := ext
+	if wall&hasMonotonic != 0 {
+		sec = wallToInternal + int64(wall<<1>>(nsecShift+1))
+	}
+	return time.Unix(sec-unixToInternal, nsec), nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+// FindGoroutines is part of the SnapshotService interface.
+func (s *grpcServer) FindGoroutines(
+	ctx context.Context, in *agentrpc.FindGoroutinesIn,
+) (*agentrpc.FindGoroutinesOut, error) {
+	match, err := valueMatcher(in.MatchMode, in.Value)
+	if err != nil {
+		return nil, err
+	}
+	matchers, err := newFrameMatchers(in.FrameSpecs)
+	if err != nil {
+		return nil, err
+	}
+	extractors, err := newContextExtractors(in.ContextExtractors)
+	if err != nil {
+		return nil, err
+	}
+	if len(matchers) == 0 && len(extractors) == 0 {
+		return nil, fmt.Errorf("no frame specs or context extractors specified")
+	}
+	exprs := make(map[string]struct{}, len(in.Expressions))
+	for _, e := range in.Expressions {
+		exprs[e] = struct{}{}
+	}
+
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	walk, err := s.walkStacks(walkOptions{maxDepth: in.MaxStackDepth, extractors: extractors})
+	if err != nil {
+		return nil, err
+	}
+	evalTasks := frameSpecTasks(matchers, walk.stacks, walk.FrameIndexes)
+	evalTasks = append(evalTasks, extractorTasks(extractors, walk.stacks, walk.FrameArgs, walk.FrameIndexes)...)
+	captured, err := s.evalExprs(evalTasks, nil /* typeSpecs */)
+	if err != nil {
+		return nil, err
+	}
+	framesOfInterest := groupCaptured(evalTasks, captured)
+
+	out := &agentrpc.FindGoroutinesOut{NumGoroutines: int32(len(walk.stacks.Goroutines))}
+	goroutines := append([]*pp.Goroutine(nil), walk.stacks.Goroutines...)
+	sort.Slice(goroutines, func(i, j int) bool {
+		return goroutines[i].ID < goroutines[j].ID
+	})
+	for _, g := range goroutines {
+		var matches []*agentrpc.FrameData
+		fois := framesOfInterest[g.ID]
+		frameIdxs := make([]int, 0, len(fois))
+		for frameIdx := range fois {
+			frameIdxs = append(frameIdxs, frameIdx)
+		}
+		sort.Ints(frameIdxs)
+		for _, frameIdx := range frameIdxs {
+			var matching []CapturedExpr
+			for _, c := range fois[frameIdx] {
+				if _, ok := exprs[c.Expr]; len(exprs) > 0 && !ok {
+					continue
+				}
+				if match(&c) {
+					matching = append(matching, c)
+				}
+			}
+			if len(matching) > 0 {
+				matches = append(matches, frameDataToProto(g.ID, frameIdx, matching))
+			}
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		if in.MaxResults > 0 && len(out.Goroutines) == int(in.MaxResults) {
+			out.Truncated = true
+			break
+		}
+		out.Goroutines = append(out.Goroutines, &agentrpc.GoroutineMatch{
+			GoroutineId: int64(g.ID),
+			Stack:       stackToProto(g.Stack.Calls),
+			Matches:     matches,
+		})
+	}
+	return out, nil
+}
+
+// valueMatcher returns a function that checks whether a captured value matches
+// value according to mode. Values are compared in their raw form; see
+// CapturedExpr.rawValue. A truncated value never matches exactly.
+func valueMatcher(mode agentrpc.FindGoroutinesIn_MatchMode, value string) (func(*CapturedExpr) bool, error) {
+	var match func(v string, truncated bool) bool
+	switch mode {
+	case agentrpc.FindGoroutinesIn_EXACT:
+		match = func(v string, truncated bool) bool { return !truncated && v == value }
+	case agentrpc.FindGoroutinesIn_SUBSTRING:
+		match = func(v string, _ bool) bool { return strings.Contains(v, value) }
+	case agentrpc.FindGoroutinesIn_REGEX:
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression: %w", err)
+		}
+		match = func(v string, _ bool) bool { return re.MatchString(v) }
+	default:
+		return nil, fmt.Errorf("unsupported match mode %s", mode)
+	}
+	return func(c *CapturedExpr) bool {
+		if c.Err != "" {
+			return false
+		}
+		return match(c.rawValue())
+	}, nil
+}
+
+func stackToProto(calls []pp.Call) []*agentrpc.StackFrame {
+	frames := make([]*agentrpc.StackFrame, len(calls))
+	for i, call := range calls {
+		frames[i] = &agentrpc.StackFrame{
+			Function: call.Func.Complete,
+			File:     call.RemoteSrcPath,
+			Line:     int64(call.Line),
+		}
+	}
+	return frames
+}
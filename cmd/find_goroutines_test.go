@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+)
+
+func TestValueMatcher(t *testing.T) {
+	str := &CapturedExpr{
+		Expr:       "stmt.SQL",
+		Val:        `"SELECT 1"`,
+		Structured: &capturedValue{Kind: "string", Value: "SELECT 1", Len: 8},
+	}
+	truncated := &CapturedExpr{
+		Expr:       "stmt.SQL",
+		Val:        `"SELECT a...+10 more"`,
+		Structured: &capturedValue{Kind: "string", Value: "SELECT a", Len: 18, Truncated: true},
+	}
+	empty := &CapturedExpr{
+		Expr:       "stmt.SQL",
+		Val:        `""`,
+		Structured: &capturedValue{Kind: "string"},
+	}
+	num := &CapturedExpr{
+		Expr:       "n",
+		Val:        "42",
+		Structured: &capturedValue{Kind: "int", Value: "42"},
+	}
+	failed := &CapturedExpr{Expr: "stmt.SQL", Err: "nil pointer dereference"}
+	for _, tc := range []struct {
+		name  string
+		mode  agentrpc.FindGoroutinesIn_MatchMode
+		value string
+		c     *CapturedExpr
+		exp   bool
+	}{
+		{name: "exact string", mode: agentrpc.FindGoroutinesIn_EXACT, value: "SELECT 1", c: str, exp: true},
+		{name: "exact quoted string", mode: agentrpc.FindGoroutinesIn_EXACT, value: `"SELECT 1"`, c: str, exp: false},
+		{name: "exact empty string", mode: agentrpc.FindGoroutinesIn_EXACT, value: "", c: empty, exp: true},
+		{name: "exact truncated string", mode: agentrpc.FindGoroutinesIn_EXACT, value: "SELECT a", c: truncated, exp: false},
+		{name: "exact int", mode: agentrpc.FindGoroutinesIn_EXACT, value: "42", c: num, exp: true},
+		{name: "substring", mode: agentrpc.FindGoroutinesIn_SUBSTRING, value: "SELECT", c: str, exp: true},
+		{name: "substring of truncated string", mode: agentrpc.FindGoroutinesIn_SUBSTRING, value: "SELECT a", c: truncated, exp: true},
+		{name: "substring not in truncated string", mode: agentrpc.FindGoroutinesIn_SUBSTRING, value: "more", c: truncated, exp: false},
+		{name: "regex anchored", mode: agentrpc.FindGoroutinesIn_REGEX, value: "^SELECT 1$", c: str, exp: true},
+		{name: "failed evaluation", mode: agentrpc.FindGoroutinesIn_SUBSTRING, value: "nil", c: failed, exp: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			match, err := valueMatcher(tc.mode, tc.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res := match(tc.c); res != tc.exp {
+				t.Fatalf("expected %t, got %t", tc.exp, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+// funcFrameTasks returns tasks evaluating expr in the frames of the goroutines
+// in snap whose function is one of funcs. For every goroutine, the tasks are
+// ordered from the leaf frame towards the root.
+func funcFrameTasks(
+	snap *pp.Snapshot, frameIndexes map[int][]int, funcs map[string]struct{}, expr string, cfg loadConfig,
+) []evalTask {
+	var tasks []evalTask
+	for _, g := range snap.Goroutines {
+		for i := range g.Stack.Calls {
+			fn := g.Stack.Calls[i].Func.Complete
+			if _, ok := funcs[fn]; !ok {
+				continue
+			}
+			tasks = append(tasks, evalTask{
+				GoroutineID:    g.ID,
+				FrameIdx:       frameIndexes[g.ID][i],
+				OutputFrameIdx: i,
+				FuncName:       fn,
+				Expr:           expr,
+				LoadConfig:     cfg,
+			})
+		}
+	}
+	return tasks
+}
+
+// findStructField returns the first field with the given name found in a
+// breadth-first search of v's children, or nil.
+func findStructField(v *capturedValue, name string) *capturedValue {
+	queue := []*capturedValue{v}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, c := range v.Children {
+			if c.Name == name {
+				return c
+			}
+			queue = append(queue, c)
+		}
+	}
+	return nil
+}
+
+// referenceLoadConfig is the configuration used for loading the frames'
+// variables when searching for references to objects. It follows pointers
+// from the variables two levels deep, which covers references like
+// (*s).mu and (*(*s).foo).mu.
+var referenceLoadConfig = loadConfig{
+	FollowPointers:     true,
+	FollowInterfaces:   true,
+	MaxVariableRecurse: 2,
+	MaxStructFields:    100,
+}
+
+// reference is a reference to an object, found in a frame's variables by
+// find_references.star.
+type reference struct {
+	GoroutineID int    `json:"GoroutineID"`
+	FrameIdx    int    `json:"FrameIdx"`
+	Function    string `json:"Function"`
+	File        string `json:"File"`
+	Line        int64  `json:"Line"`
+	// Target is the address of the referenced object.
+	Target uint64 `json:"Target"`
+	// Path is the expression, rooted at one of the frame's arguments or local
+	// variables, that evaluates to the object (e.g. "(*s).mu").
+	Path string `json:"Path"`
+}
+
+// findReferences searches the variables of the frames of all the goroutines
+// for references to the objects at the target addresses. The objects have one
+// of targetTypes. The target needs to be halted.
+func (s *grpcServer) findReferences(targets []uint64, targetTypes []string, maxDepth int32) ([]reference, error) {
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	sc, err := s.scripts.get(findReferencesScript)
+	if err != nil {
+		return nil, err
+	}
+	stackDepth := int(maxDepth)
+	if stackDepth <= 0 {
+		stackDepth = defaultStackDepth
+	} else if stackDepth > maxStackDepth {
+		stackDepth = maxStackDepth
+	}
+	script, err := withParams(sc.source, scriptParams{
+		"targets":         targets,
+		"target_types":    targetTypes,
+		"max_stack_depth": stackDepth,
+		"load_config":     referenceLoadConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.client.ExecScript(script)
+	if err != nil {
+		return nil, fmt.Errorf("executing script failed: %w\nOutput:%s", err, res.Output)
+	}
+	unquoted, err := strconv.Unquote(res.Val)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse script results: %w", err)
+	}
+	var refs []reference
+	if err := json.Unmarshal([]byte(unquoted), &refs); err != nil {
+		return nil, fmt.Errorf("failed to parse script results: %w", err)
+	}
+	return refs, nil
+}
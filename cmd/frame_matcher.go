@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+// funcMatcher matches functions by name according to a FrameSpec_MatchMode.
+type funcMatcher struct {
+	mode    agentrpc.FrameSpec_MatchMode
+	pattern string
+	// re is the compiled pattern, for the REGEX match mode.
+	re *regexp.Regexp
+}
+
+func newFuncMatcher(mode agentrpc.FrameSpec_MatchMode, pattern string) (funcMatcher, error) {
+	m := funcMatcher{mode: mode, pattern: pattern}
+	if mode == agentrpc.FrameSpec_REGEX {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return funcMatcher{}, fmt.Errorf("invalid regular expression for frame spec: %w", err)
+		}
+		m.re = re
+	}
+	return m, nil
+}
+
+// matchesFunc returns true if call's function matches.
+func (m *funcMatcher) matchesFunc(call *pp.Call) bool {
+	funcName := call.Func.Complete
+	switch m.mode {
+	case agentrpc.FrameSpec_SUFFIX:
+		return hasNameSuffix(funcName, m.pattern)
+	case agentrpc.FrameSpec_EXACT:
+		return funcName == m.pattern
+	case agentrpc.FrameSpec_REGEX:
+		return m.re.MatchString(funcName)
+	case agentrpc.FrameSpec_PACKAGE_PREFIX:
+		return hasPathPrefix(call.Func.ImportPath, m.pattern)
+	default:
+		return false
+	}
+}
+
+// hasPathPrefix returns true if path is prefix, or is inside the directory
+// prefix. "net" is a prefix of "net/http", but not of "netip".
+func hasPathPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// hasPathSuffix returns true if path is suffix, or ends with suffix preceded by
+// a slash. "sql/conn.go" is a suffix of "pkg/sql/conn.go", but "a.go" is not a
+// suffix of "pkg/data.go".
+func hasPathSuffix(path, suffix string) bool {
+	suffix = strings.TrimPrefix(suffix, "/")
+	return path == suffix || strings.HasSuffix(path, "/"+suffix)
+}
+
+// hasNameSuffix returns true if the function name is suffix, or ends with
+// suffix preceded by a separator ('.', ')' or '/'). "execStmt" is a suffix of
+// "pkg/sql.(*connExecutor).execStmt", but not of "pkg/sql.reexecStmt".
+func hasNameSuffix(funcName, suffix string) bool {
+	if !strings.HasSuffix(funcName, suffix) {
+		return false
+	}
+	const separators = ".)/"
+	if len(funcName) == len(suffix) || suffix == "" || strings.IndexByte(separators, suffix[0]) >= 0 {
+		return true
+	}
+	return strings.IndexByte(separators, funcName[len(funcName)-len(suffix)-1]) >= 0
+}
+
+// frameMatcher recognizes the stack frames that a FrameSpec applies to.
+type frameMatcher struct {
+	funcMatcher
+	spec *agentrpc.FrameSpec
+	// exprCfgs maps each of the spec's expressions to the load configuration
+	// to use for evaluating it.
+	exprCfgs map[string]loadConfig
+	// relExprs are the spec's relative expressions.
+	relExprs []relativeExpr
+}
+
+// relativeExpr is a FrameSpec_RelativeExpression prepared for finding the
+// frame it refers to.
+type relativeExpr struct {
+	*agentrpc.FrameSpec_RelativeExpression
+	// enclosing matches the enclosing function, if the expression specifies
+	// one.
+	enclosing *funcMatcher
+	cfg       loadConfig
+}
+
+func newFrameMatchers(specs []*agentrpc.FrameSpec) ([]*frameMatcher, error) {
+	matchers := make([]*frameMatcher, len(specs))
+	for i, spec := range specs {
+		fm, err := newFuncMatcher(spec.MatchMode, spec.FuncName)
+		if err != nil {
+			return nil, err
+		}
+		m := &frameMatcher{
+			funcMatcher: fm,
+			spec:        spec,
+			exprCfgs:    make(map[string]loadConfig, len(spec.Expressions)),
+		}
+		frameCfg := defaultLoadConfig.merge(spec.LoadConfig)
+		for _, expr := range spec.Expressions {
+			m.exprCfgs[expr] = frameCfg.merge(spec.ExpressionLoadConfigs[expr])
+		}
+		for _, e := range spec.RelativeExpressions {
+			rel := relativeExpr{
+				FrameSpec_RelativeExpression: e,
+				cfg:                          frameCfg.merge(e.LoadConfig),
+			}
+			if e.EnclosingFunc != "" {
+				enclosing, err := newFuncMatcher(e.EnclosingMatchMode, e.EnclosingFunc)
+				if err != nil {
+					return nil, err
+				}
+				rel.enclosing = &enclosing
+			}
+			m.relExprs = append(m.relExprs, rel)
+		}
+		matchers[i] = m
+	}
+	return matchers, nil
+}
+
+// matches returns true if call's function matches the spec and call is stopped
+// within the spec's line and PC ranges, if any.
+func (m *frameMatcher) matches(call *pp.Call) bool {
+	if !m.matchesFunc(call) {
+		return false
+	}
+
+	if r := m.spec.LineRange; r != nil {
+		if r.File != "" && !hasPathSuffix(call.RemoteSrcPath, r.File) {
+			return false
+		}
+		endLine := r.EndLine
+		if endLine == 0 {
+			endLine = r.StartLine
+		}
+		if call.Line < int(r.StartLine) || call.Line > int(endLine) {
+			return false
+		}
+	}
+	if r := m.spec.PcOffsetRange; r != nil {
+		if call.PCOffset < r.Start || (r.End != 0 && call.PCOffset >= r.End) {
+			return false
+		}
+	}
+	return true
+}
+
+// targetFrame returns the index of the frame in whose scope e is to be
+// evaluated, given the stack and the index of the frame that matched e's
+// FrameSpec. Returns false if there is no such frame.
+func (e *relativeExpr) targetFrame(calls []pp.Call, matchIdx int) (int, bool) {
+	if e.enclosing != nil {
+		for i := matchIdx + 1; i < len(calls); i++ {
+			if e.enclosing.matchesFunc(&calls[i]) {
+				return i, true
+			}
+		}
+		return 0, false
+	}
+	i := matchIdx + int(e.FrameOffset)
+	if i < 0 || i >= len(calls) {
+		return 0, false
+	}
+	return i, true
+}
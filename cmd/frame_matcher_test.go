@@ -0,0 +1,183 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+func TestFrameMatcherMatches(t *testing.T) {
+	call := pp.Call{
+		Func: pp.Func{
+			Complete:   "github.com/cockroachdb/cockroach/pkg/sql.(*connExecutor).execStmt",
+			ImportPath: "github.com/cockroachdb/cockroach/pkg/sql",
+		},
+		RemoteSrcPath: "/go/src/github.com/cockroachdb/cockroach/pkg/sql/conn_executor_exec.go",
+		Line:          276,
+		PCOffset:      0x40,
+	}
+	for _, tc := range []struct {
+		name string
+		spec *agentrpc.FrameSpec
+		// funcName, if set, replaces the name of the call's function.
+		funcName string
+		exp      bool
+	}{
+		{
+			name: "suffix",
+			spec: &agentrpc.FrameSpec{FuncName: "execStmt"},
+			exp:  true,
+		},
+		{
+			name: "suffix mismatch",
+			spec: &agentrpc.FrameSpec{FuncName: "run"},
+			exp:  false,
+		},
+		{
+			name: "suffix needs a whole name",
+			spec: &agentrpc.FrameSpec{FuncName: "Stmt"},
+			exp:  false,
+		},
+		{
+			name:     "suffix needs a separator before the name",
+			spec:     &agentrpc.FrameSpec{FuncName: "execStmt"},
+			funcName: "github.com/cockroachdb/cockroach/pkg/sql.(*connExecutor).reexecStmt",
+			exp:      false,
+		},
+		{
+			name: "suffix with the receiver",
+			spec: &agentrpc.FrameSpec{FuncName: "(*connExecutor).execStmt"},
+			exp:  true,
+		},
+		{
+			name: "suffix with the package",
+			spec: &agentrpc.FrameSpec{FuncName: "sql.(*connExecutor).execStmt"},
+			exp:  true,
+		},
+		{
+			name: "exact",
+			spec: &agentrpc.FrameSpec{
+				FuncName:  "github.com/cockroachdb/cockroach/pkg/sql.(*connExecutor).execStmt",
+				MatchMode: agentrpc.FrameSpec_EXACT,
+			},
+			exp: true,
+		},
+		{
+			name: "exact needs the full name",
+			spec: &agentrpc.FrameSpec{FuncName: "sql.(*connExecutor).execStmt", MatchMode: agentrpc.FrameSpec_EXACT},
+			exp:  false,
+		},
+		{
+			name: "regex",
+			spec: &agentrpc.FrameSpec{FuncName: `connExecutor\)\.(run|execStmt)$`, MatchMode: agentrpc.FrameSpec_REGEX},
+			exp:  true,
+		},
+		{
+			name: "package prefix",
+			spec: &agentrpc.FrameSpec{FuncName: "github.com/cockroachdb/cockroach/pkg", MatchMode: agentrpc.FrameSpec_PACKAGE_PREFIX},
+			exp:  true,
+		},
+		{
+			name: "package prefix with trailing slash",
+			spec: &agentrpc.FrameSpec{FuncName: "github.com/cockroachdb/cockroach/pkg/", MatchMode: agentrpc.FrameSpec_PACKAGE_PREFIX},
+			exp:  true,
+		},
+		{
+			name: "package prefix is the package",
+			spec: &agentrpc.FrameSpec{FuncName: "github.com/cockroachdb/cockroach/pkg/sql", MatchMode: agentrpc.FrameSpec_PACKAGE_PREFIX},
+			exp:  true,
+		},
+		{
+			name: "package prefix needs whole path elements",
+			spec: &agentrpc.FrameSpec{FuncName: "github.com/cockroachdb/cockroach/pkg/sq", MatchMode: agentrpc.FrameSpec_PACKAGE_PREFIX},
+			exp:  false,
+		},
+		{
+			name: "line range",
+			spec: &agentrpc.FrameSpec{
+				FuncName:  "execStmt",
+				LineRange: &agentrpc.FrameSpec_LineRange{File: "sql/conn_executor_exec.go", StartLine: 270, EndLine: 280},
+			},
+			exp: true,
+		},
+		{
+			name: "line range without end line",
+			spec: &agentrpc.FrameSpec{
+				FuncName:  "execStmt",
+				LineRange: &agentrpc.FrameSpec_LineRange{StartLine: 276},
+			},
+			exp: true,
+		},
+		{
+			name: "line range outside of the range",
+			spec: &agentrpc.FrameSpec{
+				FuncName:  "execStmt",
+				LineRange: &agentrpc.FrameSpec_LineRange{StartLine: 277, EndLine: 280},
+			},
+			exp: false,
+		},
+		{
+			name: "line range file needs whole path elements",
+			spec: &agentrpc.FrameSpec{
+				FuncName:  "execStmt",
+				LineRange: &agentrpc.FrameSpec_LineRange{File: "executor_exec.go", StartLine: 276},
+			},
+			exp: false,
+		},
+		{
+			name: "line range other file",
+			spec: &agentrpc.FrameSpec{
+				FuncName:  "execStmt",
+				LineRange: &agentrpc.FrameSpec_LineRange{File: "conn_executor.go", StartLine: 276},
+			},
+			exp: false,
+		},
+		{
+			name: "pc offset range",
+			spec: &agentrpc.FrameSpec{
+				FuncName:      "execStmt",
+				PcOffsetRange: &agentrpc.FrameSpec_PCOffsetRange{Start: 0x40, End: 0x41},
+			},
+			exp: true,
+		},
+		{
+			name: "pc offset range excludes the end",
+			spec: &agentrpc.FrameSpec{
+				FuncName:      "execStmt",
+				PcOffsetRange: &agentrpc.FrameSpec_PCOffsetRange{Start: 0x10, End: 0x40},
+			},
+			exp: false,
+		},
+		{
+			name: "pc offset range without end",
+			spec: &agentrpc.FrameSpec{
+				FuncName:      "execStmt",
+				PcOffsetRange: &agentrpc.FrameSpec_PCOffsetRange{Start: 0x40},
+			},
+			exp: true,
+		},
+		{
+			name: "pc offset range without end before the start",
+			spec: &agentrpc.FrameSpec{
+				FuncName:      "execStmt",
+				PcOffsetRange: &agentrpc.FrameSpec_PCOffsetRange{Start: 0x41},
+			},
+			exp: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			matchers, err := newFrameMatchers([]*agentrpc.FrameSpec{tc.spec})
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := call
+			if tc.funcName != "" {
+				c.Func.Complete = tc.funcName
+			}
+			if res := matchers[0].matches(&c); res != tc.exp {
+				t.Fatalf("expected %t, got %t", tc.exp, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	"github.com/go-delve/delve/service/api"
+)
+
+// GetGoroutine is part of the SnapshotService interface.
+func (s *grpcServer) GetGoroutine(
+	ctx context.Context, in *agentrpc.GetGoroutineIn,
+) (*agentrpc.GetGoroutineOut, error) {
+	depth := int(in.MaxStackDepth)
+	if depth <= 0 {
+		depth = defaultStackDepth
+	} else if depth > maxStackDepth {
+		depth = maxStackDepth
+	}
+	cfg := defaultLoadConfig.merge(in.LoadConfig)
+
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	// Delve returns up to depth+1 frames, so getting more than depth frames
+	// means that the stack is deeper.
+	apiCfg := cfg.toAPI(nil /* knownTypes */)
+	frames, err := s.client.Stacktrace(in.GoroutineId, depth, 0 /* opts */, &apiCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the stack of goroutine %d: %w", in.GoroutineId, err)
+	}
+	out := &agentrpc.GetGoroutineOut{GoroutineId: in.GoroutineId}
+	if len(frames) > depth {
+		frames = frames[:depth]
+		out.Truncated = true
+	}
+	out.Frames = make([]*agentrpc.GoroutineFrame, len(frames))
+	for i := range frames {
+		f := &frames[i]
+		frame := &agentrpc.GoroutineFrame{
+			Frame: &agentrpc.StackFrame{File: f.File, Line: int64(f.Line)},
+			Pc:    f.PC,
+			Error: f.Err,
+		}
+		if f.Function != nil {
+			frame.Frame.Function = f.Function.Name()
+		}
+		frame.Arguments = valuesToProto(f.Arguments)
+		frame.Locals = valuesToProto(f.Locals)
+		out.Frames[i] = frame
+	}
+	return out, nil
+}
+
+// valuesToProto converts variables read from the target into agentrpc.Values.
+func valuesToProto(vars []api.Variable) []*agentrpc.Value {
+	var res []*agentrpc.Value
+	for i := range vars {
+		// The conversion only fails when reloading values.
+		v, _ := newCapturedValue(&vars[i], nil /* reload */)
+		res = append(res, v.toProto())
+	}
+	return res
+}
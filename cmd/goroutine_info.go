@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	"github.com/go-delve/delve/service/api"
+	"golang.org/x/sys/unix"
+)
+
+// goroutineStatuses are the names of the runtime's goroutine statuses (the _G*
+// constants in runtime/runtime2.go), indexed by status.
+var goroutineStatuses = [...]string{
+	"idle",
+	"runnable",
+	"running",
+	"syscall",
+	"waiting",
+	"moribund",
+	"dead",
+	"enqueue",
+	"copystack",
+	"preempted",
+}
+
+// gScanStatus is the bit that the runtime sets in a goroutine's status while
+// the goroutine's stack is being scanned.
+const gScanStatus = 0x1000
+
+func goroutineStatusName(status uint64) string {
+	status &^= gScanStatus
+	if status < uint64(len(goroutineStatuses)) {
+		return goroutineStatuses[status]
+	}
+	return fmt.Sprintf("status %d", status)
+}
+
+// goroutineInfos returns the records of the goroutines in gs, ordered by ID.
+// parentIDs maps goroutine IDs to the IDs of their parents, if known. labels
+// maps goroutine IDs to the goroutines' pprof labels.
+func (s *grpcServer) goroutineInfos(
+	gs []*api.Goroutine, parentIDs map[int]int64, labels map[int]map[string]string,
+) []*agentrpc.GoroutineInfo {
+	waitReasons := s.waitReasons()
+	// The runtime records when goroutines started waiting using nanotime(),
+	// which is the monotonic clock shared by all the processes on the host.
+	var now unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &now); err != nil {
+		log.Printf("failed to read the monotonic clock; wait durations will not be reported: %v", err)
+	}
+
+	res := make([]*agentrpc.GoroutineInfo, len(gs))
+	for i, g := range gs {
+		info := &agentrpc.GoroutineInfo{
+			Id:        g.ID,
+			Status:    goroutineStatusName(g.Status),
+			ParentId:  parentIDs[int(g.ID)],
+			ThreadId:  int64(g.ThreadID),
+			Labels:    labels[int(g.ID)],
+			CreatedBy: locationToProto(g.GoStatementLoc),
+		}
+		if fn := g.StartLoc.Function; fn != nil {
+			info.StartFunction = fn.Name()
+		}
+		if status := g.Status &^ gScanStatus; status == api.GoroutineWaiting || status == api.GoroutineSyscall {
+			if g.WaitReason != 0 {
+				if g.WaitReason > 0 && g.WaitReason < int64(len(waitReasons)) {
+					info.WaitReason = waitReasons[g.WaitReason]
+				} else {
+					info.WaitReason = fmt.Sprintf("wait reason %d", g.WaitReason)
+				}
+			}
+			if g.WaitSince > 0 && now.Nano() > g.WaitSince {
+				info.WaitDurationNanos = now.Nano() - g.WaitSince
+			}
+		}
+		res[i] = info
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Id < res[j].Id
+	})
+	return res
+}
+
+// waitReasons reads the names of the goroutine wait reasons from the target.
+// The runtime's list of wait reasons changes between Go versions, so it is not
+// hardcoded. Returns nil if the list could not be read.
+func (s *grpcServer) waitReasons() []string {
+	v, err := s.client.EvalVariable(api.EvalScope{GoroutineID: -1}, "runtime.waitReasonStrings", api.LoadConfig{
+		MaxStringLen:   64,
+		MaxArrayValues: 256,
+	})
+	if err != nil {
+		log.Printf("failed to read the runtime's wait reasons: %v", err)
+		return nil
+	}
+	res := make([]string, len(v.Children))
+	for i := range v.Children {
+		res[i] = v.Children[i].Value
+	}
+	return res
+}
+
+// tracksParentGoroutines returns true if the target's runtime records the ID of
+// the goroutine that created each goroutine. g is any of the target's
+// goroutines.
+func (s *grpcServer) tracksParentGoroutines(g *api.Goroutine) bool {
+	v, err := s.client.EvalVariable(api.EvalScope{GoroutineID: g.ID}, "runtime.curg", api.LoadConfig{
+		MaxStructFields: -1,
+	})
+	if err != nil {
+		log.Printf("failed to read the runtime's g struct: %v", err)
+		return false
+	}
+	return field(v, "parentGoid") != nil
+}
+
+// listGoroutines returns all the target's goroutines.
+func (s *grpcServer) listGoroutines() ([]*api.Goroutine, error) {
+	gs, _, err := s.client.ListGoroutines(0 /* start */, 0 /* count */)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goroutines: %w", err)
+	}
+	return gs, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+// notCapturedValue is the value reported for a group_by expression that was
+// not captured for a group's goroutines.
+const notCapturedValue = "<not captured>"
+
+// name returns the name under which the task's result is reported: the
+// extractor's name for the tasks of context extractors, and the expression
+// otherwise.
+func (t *evalTask) name() string {
+	if t.Extractor != "" {
+		return t.Extractor
+	}
+	return t.Expr
+}
+
+// capturedLabels returns, for every goroutine, the captured values of the
+// expressions (or extractors) in names, keyed by name. results correspond to
+// tasks. For each name, the value from the frame closest to the leaf among the
+// frames where the evaluation succeeded is used.
+//
+// The values are raw; see CapturedExpr.rawValue. Values that were cut short
+// when they were read from the target are marked with a "..." suffix, like the
+// ones shortened by truncateLabels.
+func capturedLabels(
+	tasks []evalTask, results []CapturedExpr, names map[string]struct{},
+) map[int]map[string]string {
+	labels := make(map[int]map[string]string)
+	for i := range tasks {
+		task := &tasks[i]
+		if _, ok := names[task.name()]; !ok || results[i].Err != "" {
+			continue
+		}
+		gLabels, ok := labels[task.GoroutineID]
+		if !ok {
+			gLabels = make(map[string]string)
+			labels[task.GoroutineID] = gLabels
+		}
+		// The tasks of a goroutine are ordered from the leaf frame towards the
+		// root, so the first value wins.
+		if _, ok := gLabels[task.name()]; !ok {
+			v, truncated := results[i].rawValue()
+			if truncated {
+				v += "..."
+			}
+			gLabels[task.name()] = v
+		}
+	}
+	return labels
+}
+
+// addPProfLabels adds the goroutines' pprof labels, keyed by goroutine ID, to
+// the labels of their samples. A captured value takes precedence over a pprof
+// label with the same key.
+func addPProfLabels(labels map[int]map[string]string, pprofLabels map[int]map[string]string) {
+	for gID, gpLabels := range pprofLabels {
+		gLabels, ok := labels[gID]
+		if !ok {
+			gLabels = make(map[string]string, len(gpLabels))
+			labels[gID] = gLabels
+		}
+		for k, v := range gpLabels {
+			if _, ok := gLabels[k]; !ok {
+				gLabels[k] = v
+			}
+		}
+	}
+}
+
+// groupGoroutines groups the goroutines in snap by the values of the groupBy
+// expressions. labels contains the captured values, as returned by
+// capturedLabels. The groups are ordered by decreasing size, and then by
+// values.
+func groupGoroutines(
+	snap *pp.Snapshot, labels map[int]map[string]string, groupBy []string,
+) []*agentrpc.ValueGroup {
+	if len(groupBy) == 0 {
+		return nil
+	}
+	groups := make(map[string]*agentrpc.ValueGroup)
+	var res []*agentrpc.ValueGroup
+	for _, g := range snap.Goroutines {
+		values := make([]string, len(groupBy))
+		for i, expr := range groupBy {
+			v, ok := labels[g.ID][expr]
+			if !ok {
+				v = notCapturedValue
+			}
+			values[i] = v
+		}
+		k := fmt.Sprintf("%q", values)
+		group, ok := groups[k]
+		if !ok {
+			group = &agentrpc.ValueGroup{Values: values}
+			groups[k] = group
+			res = append(res, group)
+		}
+		group.NumGoroutines++
+		group.GoroutineIds = append(group.GoroutineIds, int64(g.ID))
+	}
+	for _, group := range res {
+		sort.Slice(group.GoroutineIds, func(i, j int) bool {
+			return group.GoroutineIds[i] < group.GoroutineIds[j]
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].NumGoroutines != res[j].NumGoroutines {
+			return res[i].NumGoroutines > res[j].NumGoroutines
+		}
+		return fmt.Sprint(res[i].Values) < fmt.Sprint(res[j].Values)
+	})
+	return res
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCapturedLabels(t *testing.T) {
+	str := func(s string, l int64) CapturedExpr {
+		return CapturedExpr{
+			Expr:       "stmt.SQL",
+			Val:        "<rendered>",
+			Structured: &capturedValue{Kind: "string", Value: s, Len: l, Truncated: int64(len(s)) < l},
+		}
+	}
+	tasks := []evalTask{
+		{GoroutineID: 1, FrameIdx: 2, Expr: "stmt.SQL"},
+		{GoroutineID: 1, FrameIdx: 3, Expr: "stmt.SQL"},
+		{GoroutineID: 2, FrameIdx: 2, Expr: "stmt.SQL"},
+		{GoroutineID: 3, FrameIdx: 2, Expr: "stmt.SQL"},
+		{GoroutineID: 4, FrameIdx: 2, Expr: "stmt.SQL"},
+		{GoroutineID: 4, FrameIdx: 3, Expr: "stmt.SQL"},
+		{GoroutineID: 5, FrameIdx: 2, Expr: "n"},
+		{GoroutineID: 5, FrameIdx: 2, Expr: "other"},
+	}
+	results := []CapturedExpr{
+		str("SELECT 1", 8),
+		str("SELECT 2", 8),
+		// The values of 2 and 3 only differ past MaxStringLen.
+		str("SELECT a", 18),
+		str("SELECT a", 20),
+		{Expr: "stmt.SQL", Err: "nil pointer dereference"},
+		str("", 0),
+		{Expr: "n", Val: "42", Structured: &capturedValue{Kind: "int", Value: "42"}},
+		{Expr: "other", Val: "1", Structured: &capturedValue{Kind: "int", Value: "1"}},
+	}
+	labels := capturedLabels(tasks, results, map[string]struct{}{"stmt.SQL": {}, "n": {}})
+	exp := map[int]map[string]string{
+		1: {"stmt.SQL": "SELECT 1"},
+		2: {"stmt.SQL": "SELECT a..."},
+		3: {"stmt.SQL": "SELECT a..."},
+		4: {"stmt.SQL": ""},
+		5: {"n": "42"},
+	}
+	if !reflect.DeepEqual(labels, exp) {
+		t.Fatalf("expected %v, got %v", exp, labels)
+	}
+
+	snap := testWalk(t, blockedG(1, 20), blockedG(2, 20), blockedG(3, 20), blockedG(4, 20), blockedG(5, 20)).stacks
+	var groups [][]string
+	for _, g := range groupGoroutines(snap, labels, []string{"stmt.SQL"}) {
+		groups = append(groups, g.Values)
+	}
+	if exp := [][]string{{"SELECT a..."}, {"<not captured>"}, {"SELECT 1"}, {""}}; !reflect.DeepEqual(groups, exp) {
+		t.Fatalf("expected groups %q, got %q", exp, groups)
+	}
+}
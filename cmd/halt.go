@@ -0,0 +1,90 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-delve/delve/service/rpc2"
+)
+
+// haltCoordinator arbitrates halting and resuming the target between
+// concurrent requests. The target is halted when the first request needs it
+// halted, and resumed when the last such request is done with it. Without the
+// coordination, a request finishing early would resume the target from under
+// another one that's still inspecting it.
+type haltCoordinator struct {
+	client *rpc2.RPCClient
+
+	mu sync.Mutex
+	// holders is the number of requests currently needing the target halted.
+	holders int
+	// continued, if not nil, is closed when the Continue() issued when the
+	// target was last resumed returns.
+	continued chan struct{}
+}
+
+// continuePollInterval is how often the target's state is polled while
+// waiting for it to start running after being resumed.
+const continuePollInterval = time.Millisecond
+
+func newHaltCoordinator(client *rpc2.RPCClient) *haltCoordinator {
+	return &haltCoordinator{client: client}
+}
+
+// halt halts the target, if it isn't already halted on behalf of another
+// request. The returned function needs to be called when the caller no longer
+// needs the target halted; calling it more than once is a no-op.
+func (h *haltCoordinator) halt() (resume func(), _ error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.holders == 0 {
+		if _ /* state */, err := h.client.Halt(); err != nil {
+			return nil, err
+		}
+		// Halting interrupts the previous Continue(); wait for it to return so
+		// that it doesn't overlap with the next one.
+		if h.continued != nil {
+			<-h.continued
+			h.continued = nil
+		}
+	}
+	h.holders++
+
+	var once sync.Once
+	return func() {
+		once.Do(h.release)
+	}, nil
+}
+
+// release resumes the target if no other request needs it halted. It returns
+// once Delve reports the target as running, so that a subsequent halt() can't
+// overtake the Continue().
+func (h *haltCoordinator) release() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.holders--
+	if h.holders > 0 {
+		return
+	}
+	// Continue blocks until the target stops again, so we do it on a
+	// different goroutine.
+	done := make(chan struct{})
+	h.continued = done
+	go func() {
+		defer close(done)
+		for range h.client.Continue() {
+		}
+	}()
+	for {
+		state, err := h.client.GetStateNonBlocking()
+		if err != nil || state.Running {
+			return
+		}
+		select {
+		case <-done:
+			// The target stopped on its own already (e.g. it exited).
+			return
+		case <-time.After(continuePollInterval):
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+const (
+	// defaultLeakSnapshots and defaultLeakInterval are the number of snapshots
+	// taken by DetectLeaks and the time between them, unless the request
+	// specifies otherwise.
+	defaultLeakSnapshots = 3
+	defaultLeakInterval  = 5 * time.Second
+	// maxLeakSnapshots is the upper bound for the number of snapshots that
+	// DetectLeaks requests can ask for.
+	maxLeakSnapshots = 20
+	// maxLeakDetectionDuration is the upper bound for the time between the
+	// first and the last snapshot taken by DetectLeaks.
+	maxLeakDetectionDuration = 10 * time.Minute
+)
+
+// DetectLeaks is part of the SnapshotService interface.
+func (s *grpcServer) DetectLeaks(
+	ctx context.Context, in *agentrpc.DetectLeaksIn,
+) (*agentrpc.DetectLeaksOut, error) {
+	if in.Aggregation == agentrpc.GetSnapshotIn_NONE {
+		return nil, fmt.Errorf("aggregation mode %s is not supported for leak detection", in.Aggregation)
+	}
+	numSnapshots := int(in.NumSnapshots)
+	if numSnapshots == 0 {
+		numSnapshots = defaultLeakSnapshots
+	} else if numSnapshots < 2 {
+		return nil, fmt.Errorf("leak detection needs at least 2 snapshots")
+	} else if numSnapshots > maxLeakSnapshots {
+		numSnapshots = maxLeakSnapshots
+	}
+	interval := time.Duration(in.IntervalMs) * time.Millisecond
+	if interval <= 0 {
+		interval = defaultLeakInterval
+	}
+	if max := maxLeakDetectionDuration / time.Duration(numSnapshots-1); interval > max {
+		interval = max
+	}
+
+	out := &agentrpc.DetectLeaksOut{}
+	walks := make([]*stackWalk, numSnapshots)
+	times := make([]time.Time, numSnapshots)
+	for i := range walks {
+		if i > 0 {
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		var err error
+		walks[i], err = s.leakSnapshot(in.MaxStackDepth)
+		if err != nil {
+			return nil, err
+		}
+		times[i] = time.Now()
+		out.SnapshotUnixNanos = append(out.SnapshotUnixNanos, times[i].UnixNano())
+		out.NumGoroutines = append(out.NumGoroutines, int32(len(walks[i].stacks.Goroutines)))
+	}
+
+	stuck := stuckGoroutines(walks)
+	growing, err := growingStacks(walks, in.Aggregation, times[len(times)-1].Sub(times[0]))
+	if err != nil {
+		return nil, err
+	}
+	if in.MaxResults > 0 {
+		if len(stuck) > int(in.MaxResults) {
+			stuck = stuck[:in.MaxResults]
+			out.Truncated = true
+		}
+		if len(growing) > int(in.MaxResults) {
+			growing = growing[:in.MaxResults]
+			out.Truncated = true
+		}
+	}
+	out.GrowingStacks = growing
+
+	// Build the profile of the stuck goroutines out of the last snapshot.
+	last := walks[len(walks)-1]
+	stuckSnap := &pp.Snapshot{}
+	for _, g := range stuck {
+		out.StuckGoroutines = append(out.StuckGoroutines, &agentrpc.StuckGoroutine{
+			GoroutineId: int64(g.ID),
+			State:       g.State,
+			Stack:       stackToProto(g.Stack.Calls),
+		})
+		stuckSnap.Goroutines = append(stuckSnap.Goroutines, g)
+	}
+	addTruncationMarkers(stuckSnap, last.Truncated)
+	profile, err := scriptResultsToPProf(stuckSnap, last.funcAddrs, nil /* labels */, in.Aggregation)
+	if err != nil {
+		return nil, err
+	}
+	out.Profile = profileToProto(profile)
+	return out, nil
+}
+
+// leakSnapshot halts the target for as long as it takes to collect the stacks
+// of all the goroutines.
+func (s *grpcServer) leakSnapshot(maxDepth int32) (*stackWalk, error) {
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+	return s.walkStacks(walkOptions{maxDepth: maxDepth})
+}
+
+// stackKey identifies a stack across snapshots.
+func stackKey(calls []pp.Call) string {
+	var sb strings.Builder
+	for _, c := range calls {
+		fmt.Fprintf(&sb, "%s %s:%d\n", c.Func.Complete, c.RemoteSrcPath, c.Line)
+	}
+	return sb.String()
+}
+
+// isBlocked returns true if a goroutine in the given state is blocked.
+func isBlocked(state string) bool {
+	return state == "waiting" || state == "syscall"
+}
+
+// stuckGoroutines returns the goroutines, from the last of the walks, that were
+// blocked with the same stack in all the walks. The goroutines are ordered by
+// ID.
+func stuckGoroutines(walks []*stackWalk) []*pp.Goroutine {
+	// candidates maps goroutine IDs to the keys of their stacks in the first
+	// walk, for the blocked goroutines.
+	candidates := make(map[int]string)
+	for _, g := range walks[0].stacks.Goroutines {
+		if isBlocked(g.State) {
+			candidates[g.ID] = stackKey(g.Stack.Calls)
+		}
+	}
+	var res []*pp.Goroutine
+	for i, w := range walks[1:] {
+		last := i == len(walks)-2
+		seen := make(map[int]struct{}, len(candidates))
+		for _, g := range w.stacks.Goroutines {
+			k, ok := candidates[g.ID]
+			if !ok || !isBlocked(g.State) || stackKey(g.Stack.Calls) != k {
+				continue
+			}
+			seen[g.ID] = struct{}{}
+			if last {
+				res = append(res, g)
+			}
+		}
+		for id := range candidates {
+			if _, ok := seen[id]; !ok {
+				delete(candidates, id)
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
+
+// growingStacks returns the stacks whose number of goroutines never decreased
+// across the walks and grew between the first and the last one. Goroutines are
+// aggregated according to the aggregation mode. elapsed is the time between
+// the first and the last walk. The stacks are ordered by decreasing growth
+// rate.
+func growingStacks(
+	walks []*stackWalk, mode agentrpc.GetSnapshotIn_Aggregation, elapsed time.Duration,
+) ([]*agentrpc.GrowingStack, error) {
+	type stackCounts struct {
+		calls  []pp.Call
+		counts []int32
+		ids    []int
+	}
+	byKey := make(map[string]*stackCounts)
+	var stacks []*stackCounts
+	for i, w := range walks {
+		buckets, err := aggregateGoroutines(w.stacks, mode)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range buckets {
+			k := stackKey(b.Signature.Stack.Calls)
+			sc, ok := byKey[k]
+			if !ok {
+				sc = &stackCounts{calls: b.Signature.Stack.Calls, counts: make([]int32, len(walks))}
+				byKey[k] = sc
+				stacks = append(stacks, sc)
+			}
+			sc.counts[i] += int32(len(b.IDs))
+			if i == len(walks)-1 {
+				sc.ids = append(sc.ids, b.IDs...)
+			}
+		}
+	}
+
+	var res []*agentrpc.GrowingStack
+	for _, sc := range stacks {
+		growing := sc.counts[len(sc.counts)-1] > sc.counts[0]
+		for i := 1; i < len(sc.counts) && growing; i++ {
+			growing = sc.counts[i] >= sc.counts[i-1]
+		}
+		if !growing {
+			continue
+		}
+		gs := &agentrpc.GrowingStack{
+			Stack:  stackToProto(sc.calls),
+			Counts: sc.counts,
+		}
+		if elapsed > 0 {
+			gs.GrowthPerSecond = float64(sc.counts[len(sc.counts)-1]-sc.counts[0]) / elapsed.Seconds()
+		}
+		sort.Ints(sc.ids)
+		for _, id := range sc.ids {
+			gs.GoroutineIds = append(gs.GoroutineIds, int64(id))
+		}
+		res = append(res, gs)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].GrowthPerSecond > res[j].GrowthPerSecond
+	})
+	return res, nil
+}
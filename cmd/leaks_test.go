@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+)
+
+// testGoroutine describes a goroutine for testWalk.
+type testGoroutine struct {
+	id    int
+	state string
+	// funcs are the functions on the goroutine's stack, from the leaf to the
+	// root, with the lines of the calls.
+	funcs []string
+	lines []int
+}
+
+// testWalk returns a stackWalk with the given goroutines, formatted like the
+// output of walk_stacks.star.
+func testWalk(t *testing.T, gs ...testGoroutine) *stackWalk {
+	t.Helper()
+	stacks := make(map[int]string, len(gs))
+	for _, g := range gs {
+		s := fmt.Sprintf("goroutine %d [%s]:\n", g.id, g.state)
+		for i, fn := range g.funcs {
+			s += fmt.Sprintf("%s()\n\t/src/main.go:%d +0x0\n", fn, g.lines[i])
+		}
+		stacks[g.id] = s
+	}
+	snap, err := parseStacks(stacks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &stackWalk{stacks: snap}
+}
+
+func blockedG(id int, line int) testGoroutine {
+	return testGoroutine{
+		id:    id,
+		state: "waiting",
+		funcs: []string{"runtime.gopark", "main.worker"},
+		lines: []int{10, line},
+	}
+}
+
+func runningG(id int, line int) testGoroutine {
+	return testGoroutine{
+		id:    id,
+		state: "running",
+		funcs: []string{"main.worker"},
+		lines: []int{line},
+	}
+}
+
+func TestStuckGoroutines(t *testing.T) {
+	walks := []*stackWalk{
+		testWalk(t, blockedG(1, 20), blockedG(2, 20), blockedG(3, 20), runningG(4, 20), blockedG(5, 20)),
+		// 2 moved to a different line, 3 is running, 4 blocked, 5 exited.
+		testWalk(t, blockedG(1, 20), blockedG(2, 21), runningG(3, 20), blockedG(4, 20), blockedG(6, 20)),
+		testWalk(t, blockedG(1, 20), blockedG(2, 20), blockedG(3, 20), blockedG(4, 20), blockedG(6, 20)),
+	}
+	var ids []int
+	for _, g := range stuckGoroutines(walks) {
+		ids = append(ids, g.ID)
+	}
+	if exp := []int{1}; !reflect.DeepEqual(ids, exp) {
+		t.Fatalf("expected %v, got %v", exp, ids)
+	}
+
+	// With two walks, goroutines blocked in both are stuck.
+	ids = nil
+	for _, g := range stuckGoroutines(walks[1:]) {
+		ids = append(ids, g.ID)
+	}
+	if exp := []int{1, 4, 6}; !reflect.DeepEqual(ids, exp) {
+		t.Fatalf("expected %v, got %v", exp, ids)
+	}
+}
+
+func TestGrowingStacks(t *testing.T) {
+	// Goroutines on line 20 grow steadily, the ones on line 30 grow and then
+	// shrink, and the ones on line 40 stay the same.
+	walks := []*stackWalk{
+		testWalk(t, blockedG(1, 20), blockedG(2, 30), blockedG(3, 40)),
+		testWalk(t, blockedG(1, 20), blockedG(4, 20), blockedG(2, 30), blockedG(5, 30), blockedG(6, 30), blockedG(3, 40)),
+		testWalk(t, blockedG(1, 20), blockedG(4, 20), blockedG(7, 20), blockedG(2, 30), blockedG(5, 30), blockedG(3, 40)),
+	}
+	res, err := growingStacks(walks, agentrpc.GetSnapshotIn_SIMILAR_ANY_VALUE, 2*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 {
+		t.Fatalf("expected 1 growing stack, got %d: %v", len(res), res)
+	}
+	gs := res[0]
+	if line := gs.Stack[1].Line; line != 20 {
+		t.Fatalf("expected the stack on line 20, got line %d", line)
+	}
+	if exp := []int32{1, 2, 3}; !reflect.DeepEqual(gs.Counts, exp) {
+		t.Fatalf("expected counts %v, got %v", exp, gs.Counts)
+	}
+	if exp := []int64{1, 4, 7}; !reflect.DeepEqual(gs.GoroutineIds, exp) {
+		t.Fatalf("expected goroutines %v, got %v", exp, gs.GoroutineIds)
+	}
+	if gs.GrowthPerSecond != 1 {
+		t.Fatalf("expected a growth of 1/s, got %f", gs.GrowthPerSecond)
+	}
+
+	// Stacks are ordered by growth rate.
+	walks = []*stackWalk{
+		testWalk(t, blockedG(1, 20), blockedG(2, 30)),
+		testWalk(t, blockedG(1, 20), blockedG(3, 20), blockedG(2, 30), blockedG(4, 30), blockedG(5, 30)),
+	}
+	res, err = growingStacks(walks, agentrpc.GetSnapshotIn_SIMILAR_ANY_VALUE, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []int64
+	for _, gs := range res {
+		lines = append(lines, gs.Stack[1].Line)
+	}
+	if exp := []int64{30, 20}; !reflect.DeepEqual(lines, exp) {
+		t.Fatalf("expected stacks on lines %v, got %v", exp, lines)
+	}
+}
+
+func TestDetectLeaksRejectsNoAggregation(t *testing.T) {
+	// Without aggregation, every goroutine has its own stack and no stack can
+	// grow. The request is rejected before the target is touched.
+	s := &grpcServer{}
+	_, err := s.DetectLeaks(context.Background(), &agentrpc.DetectLeaksIn{Aggregation: agentrpc.GetSnapshotIn_NONE})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"github.com/andreimatei/delve-agent/agentrpc"
+	"github.com/go-delve/delve/service/api"
+)
+
+// loadConfig mirrors Delve's api.LoadConfig. It is passed to the Starlark
+// scripts as the configuration for eval(), so the JSON field names need to
+// match Delve's.
+type loadConfig struct {
+	FollowPointers     bool `json:"FollowPointers"`
+	FollowInterfaces   bool `json:"FollowInterfaces"`
+	MaxVariableRecurse int  `json:"MaxVariableRecurse"`
+	MaxStringLen       int  `json:"MaxStringLen"`
+	MaxArrayValues     int  `json:"MaxArrayValues"`
+	MaxStructFields    int  `json:"MaxStructFields"`
+}
+
+// toAPI returns the Delve load configuration corresponding to cfg, with the
+// given known types.
+func (cfg loadConfig) toAPI(knownTypes *[]api.KnownType) api.LoadConfig {
+	return api.LoadConfig{
+		FollowPointers:     cfg.FollowPointers,
+		FollowInterfaces:   cfg.FollowInterfaces,
+		MaxVariableRecurse: cfg.MaxVariableRecurse,
+		MaxStringLen:       cfg.MaxStringLen,
+		MaxArrayValues:     cfg.MaxArrayValues,
+		MaxStructFields:    cfg.MaxStructFields,
+		KnownTypes:         knownTypes,
+	}
+}
+
+// defaultLoadConfig is the load configuration used for evaluating expressions
+// when the request does not specify otherwise.
+var defaultLoadConfig = loadConfig{
+	FollowPointers:     true,
+	FollowInterfaces:   true,
+	MaxVariableRecurse: 2,
+	MaxStringLen:       100,
+	MaxArrayValues:     10,
+	MaxStructFields:    100,
+}
+
+// maxLoadConfig contains the upper bounds for the load configurations that
+// requests can ask for. The target is paused while the variables are loaded,
+// and the loaded values are included in the response, so we don't let a
+// request ask for arbitrary amounts of data.
+var maxLoadConfig = loadConfig{
+	MaxVariableRecurse: 10,
+	MaxStringLen:       64 << 10,
+	MaxArrayValues:     1000,
+	MaxStructFields:    1000,
+}
+
+// merge returns a copy of cfg with the fields set in override replacing the
+// corresponding ones, and with the limits capped to maxLoadConfig.
+func (cfg loadConfig) merge(override *agentrpc.LoadConfig) loadConfig {
+	if override != nil {
+		if override.FollowPointers != nil {
+			cfg.FollowPointers = *override.FollowPointers
+		}
+		if override.FollowInterfaces != nil {
+			cfg.FollowInterfaces = *override.FollowInterfaces
+		}
+		if override.MaxVariableRecurse != nil {
+			cfg.MaxVariableRecurse = int(*override.MaxVariableRecurse)
+		}
+		if override.MaxStringLen != nil {
+			cfg.MaxStringLen = int(*override.MaxStringLen)
+		}
+		if override.MaxArrayValues != nil {
+			cfg.MaxArrayValues = int(*override.MaxArrayValues)
+		}
+		if override.MaxStructFields != nil {
+			cfg.MaxStructFields = int(*override.MaxStructFields)
+		}
+	}
+	return cfg.capped()
+}
+
+// capped returns a copy of cfg with the limits capped to maxLoadConfig.
+// Negative limits, which Delve interprets as "no limit" for some fields, are
+// also replaced by the upper bound.
+func (cfg loadConfig) capped() loadConfig {
+	capLimit := func(v *int, max int) {
+		if *v < 0 || *v > max {
+			*v = max
+		}
+	}
+	capLimit(&cfg.MaxVariableRecurse, maxLoadConfig.MaxVariableRecurse)
+	capLimit(&cfg.MaxStringLen, maxLoadConfig.MaxStringLen)
+	capLimit(&cfg.MaxArrayValues, maxLoadConfig.MaxArrayValues)
+	capLimit(&cfg.MaxStructFields, maxLoadConfig.MaxStructFields)
+	return cfg
+}
+
+// typeLoadConfigs returns the load configurations for the type specs that
+// specify one, keyed by type name.
+func typeLoadConfigs(specs []*agentrpc.TypeSpec) map[string]loadConfig {
+	res := make(map[string]loadConfig)
+	for _, spec := range specs {
+		if spec.LoadConfig == nil {
+			continue
+		}
+		res[spec.TypeName] = defaultLoadConfig.merge(spec.LoadConfig)
+	}
+	return res
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLoadConfigMerge(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		override *agentrpc.LoadConfig
+		exp      loadConfig
+	}{
+		{
+			name:     "no override",
+			override: nil,
+			exp:      defaultLoadConfig,
+		},
+		{
+			name:     "empty override",
+			override: &agentrpc.LoadConfig{},
+			exp:      defaultLoadConfig,
+		},
+		{
+			name: "some fields",
+			override: &agentrpc.LoadConfig{
+				FollowPointers: proto.Bool(false),
+				MaxStringLen:   proto.Int32(1000),
+			},
+			exp: loadConfig{
+				FollowPointers:     false,
+				FollowInterfaces:   true,
+				MaxVariableRecurse: 2,
+				MaxStringLen:       1000,
+				MaxArrayValues:     10,
+				MaxStructFields:    100,
+			},
+		},
+		{
+			name: "all fields",
+			override: &agentrpc.LoadConfig{
+				FollowPointers:     proto.Bool(false),
+				FollowInterfaces:   proto.Bool(false),
+				MaxVariableRecurse: proto.Int32(0),
+				MaxStringLen:       proto.Int32(0),
+				MaxArrayValues:     proto.Int32(1),
+				MaxStructFields:    proto.Int32(2),
+			},
+			exp: loadConfig{
+				MaxArrayValues:  1,
+				MaxStructFields: 2,
+			},
+		},
+		{
+			name: "capped",
+			override: &agentrpc.LoadConfig{
+				MaxVariableRecurse: proto.Int32(100),
+				MaxStringLen:       proto.Int32(1 << 30),
+				MaxArrayValues:     proto.Int32(1 << 20),
+				MaxStructFields:    proto.Int32(1 << 20),
+			},
+			exp: loadConfig{
+				FollowPointers:     true,
+				FollowInterfaces:   true,
+				MaxVariableRecurse: maxLoadConfig.MaxVariableRecurse,
+				MaxStringLen:       maxLoadConfig.MaxStringLen,
+				MaxArrayValues:     maxLoadConfig.MaxArrayValues,
+				MaxStructFields:    maxLoadConfig.MaxStructFields,
+			},
+		},
+		{
+			name: "negative limits",
+			override: &agentrpc.LoadConfig{
+				MaxArrayValues:  proto.Int32(-1),
+				MaxStructFields: proto.Int32(-1),
+			},
+			exp: loadConfig{
+				FollowPointers:     true,
+				FollowInterfaces:   true,
+				MaxVariableRecurse: 2,
+				MaxStringLen:       100,
+				MaxArrayValues:     maxLoadConfig.MaxArrayValues,
+				MaxStructFields:    maxLoadConfig.MaxStructFields,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if res := defaultLoadConfig.merge(tc.override); res != tc.exp {
+				t.Fatalf("expected %+v, got %+v", tc.exp, res)
+			}
+		})
+	}
+}
+
+func TestLoadConfigMergeChained(t *testing.T) {
+	// Expression configs are merged on top of frame configs, which are merged
+	// on top of the defaults.
+	frameCfg := defaultLoadConfig.merge(&agentrpc.LoadConfig{MaxStringLen: proto.Int32(1000)})
+	res := frameCfg.merge(&agentrpc.LoadConfig{MaxArrayValues: proto.Int32(100)})
+	exp := defaultLoadConfig
+	exp.MaxStringLen = 1000
+	exp.MaxArrayValues = 100
+	if res != exp {
+		t.Fatalf("expected %+v, got %+v", exp, res)
+	}
+}
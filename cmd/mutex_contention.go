@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+// mutexLockFuncs are the functions that goroutines waiting to lock a mutex are
+// blocked in. Their receiver is called m. Since Go 1.24, sync.Mutex wraps an
+// internal/sync.Mutex stored at the same address.
+var mutexLockFuncs = map[string]struct{}{
+	"sync.(*Mutex).Lock":              {},
+	"sync.(*Mutex).lockSlow":          {},
+	"internal/sync.(*Mutex).Lock":     {},
+	"internal/sync.(*Mutex).lockSlow": {},
+}
+
+// mutexTypes are the types of the mutexes that the mutexLockFuncs lock.
+var mutexTypes = []string{"sync.Mutex", "internal/sync.Mutex"}
+
+// The bits of sync.Mutex.state.
+const (
+	mutexLocked      = 1
+	mutexStarving    = 4
+	mutexWaiterShift = 3
+)
+
+// mutexLoadConfig is used for reading the receivers of the mutexLockFuncs.
+var mutexLoadConfig = loadConfig{
+	FollowPointers:     true,
+	MaxVariableRecurse: 2,
+	MaxStructFields:    10,
+}
+
+// GetMutexContention is part of the SnapshotService interface.
+func (s *grpcServer) GetMutexContention(
+	ctx context.Context, in *agentrpc.GetMutexContentionIn,
+) (*agentrpc.GetMutexContentionOut, error) {
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	walk, err := s.walkStacks(walkOptions{maxDepth: in.MaxStackDepth})
+	if err != nil {
+		return nil, err
+	}
+	tasks := funcFrameTasks(walk.stacks, walk.FrameIndexes, mutexLockFuncs, "m", mutexLoadConfig)
+	captured, err := s.evalExprs(tasks, nil /* typeSpecs */)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &agentrpc.GetMutexContentionOut{}
+	byAddr := make(map[uint64]*agentrpc.MutexContention)
+	var mutexes []*agentrpc.MutexContention
+	// lockSites maps mutex addresses to the waiters' lock sites, keyed by
+	// location.
+	lockSites := make(map[uint64]map[string]*agentrpc.LockSite)
+	goroutines := make(map[int]*pp.Goroutine, len(walk.stacks.Goroutines))
+	for _, g := range walk.stacks.Goroutines {
+		goroutines[g.ID] = g
+	}
+	// The tasks of a goroutine are ordered from the leaf towards the root. The
+	// frame closest to the root is the one called by the code locking the
+	// mutex, so that's the one whose value is used.
+	type waiter struct {
+		addr     uint64
+		frameIdx int
+		value    *capturedValue
+	}
+	waiters := make(map[int]waiter)
+	var waiterIDs []int
+	for i, task := range tasks {
+		if _, ok := waiters[task.GoroutineID]; !ok {
+			waiterIDs = append(waiterIDs, task.GoroutineID)
+			waiters[task.GoroutineID] = waiter{}
+		}
+		c := captured[i]
+		if c.Err != "" || c.Structured == nil || c.Structured.PointerAddr == 0 {
+			continue
+		}
+		waiters[task.GoroutineID] = waiter{
+			addr:     c.Structured.PointerAddr,
+			frameIdx: task.OutputFrameIdx,
+			value:    c.Structured,
+		}
+	}
+	sort.Ints(waiterIDs)
+	for _, gID := range waiterIDs {
+		w := waiters[gID]
+		if w.addr == 0 {
+			out.NumUnresolvedWaiters++
+			continue
+		}
+		m, ok := byAddr[w.addr]
+		if !ok {
+			m = &agentrpc.MutexContention{Address: w.addr}
+			decodeMutexState(m, w.value)
+			byAddr[w.addr] = m
+			mutexes = append(mutexes, m)
+			lockSites[w.addr] = make(map[string]*agentrpc.LockSite)
+		}
+		m.WaiterIds = append(m.WaiterIds, int64(gID))
+		calls := goroutines[gID].Stack.Calls
+		if w.frameIdx+1 < len(calls) {
+			caller := stackToProto(calls[w.frameIdx+1 : w.frameIdx+2])[0]
+			k := fmt.Sprintf("%s %s:%d", caller.Function, caller.File, caller.Line)
+			site, ok := lockSites[w.addr][k]
+			if !ok {
+				site = &agentrpc.LockSite{Location: caller}
+				lockSites[w.addr][k] = site
+				m.LockSites = append(m.LockSites, site)
+			}
+			site.NumWaiters++
+		}
+	}
+
+	// Drop the mutexes with too few waiters before looking for holders.
+	filtered := mutexes[:0]
+	for _, m := range mutexes {
+		if len(m.WaiterIds) >= int(in.MinWaiters) {
+			filtered = append(filtered, m)
+		}
+	}
+	mutexes = filtered
+	addrs := make([]uint64, len(mutexes))
+	for i, m := range mutexes {
+		addrs[i] = m.Address
+		sort.SliceStable(m.LockSites, func(i, j int) bool {
+			return m.LockSites[i].NumWaiters > m.LockSites[j].NumWaiters
+		})
+	}
+	refs, err := s.findReferences(addrs, mutexTypes, in.MaxStackDepth)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		if w, ok := waiters[ref.GoroutineID]; ok && w.addr == ref.Target {
+			continue
+		}
+		m := byAddr[ref.Target]
+		m.HolderCandidates = append(m.HolderCandidates, &agentrpc.FrameReference{
+			GoroutineId: int64(ref.GoroutineID),
+			Frame:       &agentrpc.StackFrame{Function: ref.Function, File: ref.File, Line: ref.Line},
+			FrameIdx:    int32(ref.FrameIdx),
+			Path:        ref.Path,
+		})
+	}
+	for _, m := range mutexes {
+		holders := make(map[int64]struct{})
+		for _, c := range m.HolderCandidates {
+			holders[c.GoroutineId] = struct{}{}
+		}
+		if len(holders) == 1 {
+			m.ProbableHolderId = m.HolderCandidates[0].GoroutineId
+		}
+	}
+
+	sort.SliceStable(mutexes, func(i, j int) bool {
+		return len(mutexes[i].WaiterIds) > len(mutexes[j].WaiterIds)
+	})
+	out.Mutexes = mutexes
+	return out, nil
+}
+
+// decodeMutexState populates m's fields describing the mutex's state. v is the
+// pointer to the mutex, as read from a waiter's frame.
+func decodeMutexState(m *agentrpc.MutexContention, v *capturedValue) {
+	state := findStructField(v, "state")
+	if state == nil {
+		return
+	}
+	n, err := strconv.ParseInt(state.Value, 10, 64)
+	if err != nil {
+		return
+	}
+	m.Locked = n&mutexLocked != 0
+	m.Starving = n&mutexStarving != 0
+	m.RuntimeWaiters = int32(n >> mutexWaiterShift)
+}
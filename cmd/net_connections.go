@@ -0,0 +1,276 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+// pollFDPrefix is the prefix of the functions that goroutines blocked on
+// network I/O are blocked in. Their receiver is called fd.
+const pollFDPrefix = "internal/poll.(*FD)."
+
+// netIOPackages are the packages whose frames are skipped when looking for the
+// location of a goroutine's network operation.
+var netIOPackages = map[string]struct{}{
+	"runtime":       {},
+	"internal/poll": {},
+	"net":           {},
+	"os":            {},
+	"syscall":       {},
+}
+
+// socketTables are the files under /proc/<pid>/net describing the sockets of a
+// process' network namespace, keyed by protocol.
+var socketTables = []string{"tcp", "tcp6", "udp", "udp6"}
+
+// tcpStates are the names of the kernel's TCP states, indexed by state.
+var tcpStates = [...]string{
+	1:  "ESTABLISHED",
+	2:  "SYN_SENT",
+	3:  "SYN_RECV",
+	4:  "FIN_WAIT1",
+	5:  "FIN_WAIT2",
+	6:  "TIME_WAIT",
+	7:  "CLOSE",
+	8:  "CLOSE_WAIT",
+	9:  "LAST_ACK",
+	10: "LISTEN",
+	11: "CLOSING",
+	12: "NEW_SYN_RECV",
+}
+
+// pollFDLoadConfig is used for reading the internal/poll.FD receivers.
+var pollFDLoadConfig = loadConfig{
+	FollowPointers:     true,
+	MaxVariableRecurse: 1,
+	MaxStructFields:    10,
+}
+
+// socket is an entry of one of the socketTables.
+type socket struct {
+	protocol      string
+	localAddress  string
+	remoteAddress string
+	state         string
+}
+
+// GetNetConnections is part of the SnapshotService interface.
+func (s *grpcServer) GetNetConnections(
+	ctx context.Context, in *agentrpc.GetNetConnectionsIn,
+) (*agentrpc.GetNetConnectionsOut, error) {
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	walk, err := s.walkStacks(walkOptions{maxDepth: in.MaxStackDepth})
+	if err != nil {
+		return nil, err
+	}
+	out := &agentrpc.GetNetConnectionsOut{}
+	var tasks []evalTask
+	for _, g := range walk.stacks.Goroutines {
+		if !isBlocked(g.State) {
+			continue
+		}
+		calls := g.Stack.Calls
+		for i := range calls {
+			fn := calls[i].Func.Complete
+			if !strings.HasPrefix(fn, pollFDPrefix) {
+				continue
+			}
+			tasks = append(tasks, evalTask{
+				GoroutineID:    g.ID,
+				FrameIdx:       walk.FrameIndexes[g.ID][i],
+				OutputFrameIdx: i,
+				FuncName:       fn,
+				Expr:           "fd",
+				LoadConfig:     pollFDLoadConfig,
+			})
+			conn := &agentrpc.NetConnection{
+				GoroutineId: int64(g.ID),
+				Operation:   strings.TrimPrefix(fn, pollFDPrefix),
+			}
+			for _, c := range calls[i+1:] {
+				if _, ok := netIOPackages[funcPackage(c.Func.Complete)]; !ok {
+					conn.Location = stackToProto([]pp.Call{c})[0]
+					break
+				}
+			}
+			out.Connections = append(out.Connections, conn)
+			break
+		}
+	}
+	if len(tasks) == 0 {
+		return out, nil
+	}
+	captured, err := s.evalExprs(tasks, nil /* typeSpecs */)
+	if err != nil {
+		return nil, err
+	}
+
+	pid := s.client.ProcessPid()
+	sockets, err := readSocketTables(pid)
+	if err != nil {
+		return nil, err
+	}
+	for i, conn := range out.Connections {
+		c := captured[i]
+		if c.Err != "" || c.Structured == nil {
+			conn.Error = fmt.Sprintf("failed to read the fd: %s", c.Err)
+			continue
+		}
+		fd, ok := intField(c.Structured, "Sysfd")
+		if !ok {
+			conn.Error = "failed to read the fd: Sysfd not found"
+			continue
+		}
+		conn.Fd = fd
+		inode, err := socketInode(pid, fd)
+		if err != nil {
+			conn.Error = err.Error()
+			continue
+		}
+		conn.Inode = inode
+		if sock, ok := sockets[inode]; ok {
+			conn.Protocol = sock.protocol
+			conn.LocalAddress = sock.localAddress
+			conn.RemoteAddress = sock.remoteAddress
+			conn.State = sock.state
+		}
+	}
+	sort.SliceStable(out.Connections, func(i, j int) bool {
+		return out.Connections[i].GoroutineId < out.Connections[j].GoroutineId
+	})
+	return out, nil
+}
+
+// funcPackage returns the import path of the package of a function, given the
+// function's fully qualified name (e.g. "net/http.(*conn).serve").
+func funcPackage(fn string) string {
+	slash := strings.LastIndexByte(fn, '/')
+	if dot := strings.IndexByte(fn[slash+1:], '.'); dot >= 0 {
+		return fn[:slash+1+dot]
+	}
+	return fn
+}
+
+// socketInode returns the inode of the socket that the process' fd refers to.
+func socketInode(pid int, fd int64) (uint64, error) {
+	link, err := os.Readlink(fmt.Sprintf("/proc/%d/fd/%d", pid, fd))
+	if err != nil {
+		return 0, err
+	}
+	// Sockets look like socket:[12345].
+	if !strings.HasPrefix(link, "socket:[") || !strings.HasSuffix(link, "]") {
+		return 0, fmt.Errorf("fd %d is not a socket: %s", fd, link)
+	}
+	return strconv.ParseUint(link[len("socket:["):len(link)-1], 10, 64)
+}
+
+// readSocketTables reads the sockets of the process' network namespace, keyed
+// by inode. Tables that don't exist (e.g. tcp6 with IPv6 disabled) are skipped.
+func readSocketTables(pid int) (map[uint64]socket, error) {
+	res := make(map[uint64]socket)
+	for _, proto := range socketTables {
+		f, err := os.Open(fmt.Sprintf("/proc/%d/net/%s", pid, proto))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		err = parseSocketTable(f, proto, res)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse /proc/%d/net/%s: %w", pid, proto, err)
+		}
+	}
+	return res, nil
+}
+
+// parseSocketTable parses one of the socketTables and adds its sockets to res.
+func parseSocketTable(r io.Reader, proto string, res map[uint64]socket) error {
+	scanner := bufio.NewScanner(r)
+	// Skip the header.
+	scanner.Scan()
+	for scanner.Scan() {
+		// Lines look like:
+		// 0: 0100007F:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1000        0 123456 ...
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		inode, err := strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			return err
+		}
+		local, err := parseSocketAddr(fields[1])
+		if err != nil {
+			return err
+		}
+		remote, err := parseSocketAddr(fields[2])
+		if err != nil {
+			return err
+		}
+		st, err := strconv.ParseUint(fields[3], 16, 8)
+		if err != nil {
+			return err
+		}
+		state := fmt.Sprintf("state %d", st)
+		if st < uint64(len(tcpStates)) && tcpStates[st] != "" {
+			state = tcpStates[st]
+		}
+		res[inode] = socket{
+			protocol:      proto,
+			localAddress:  local,
+			remoteAddress: remote,
+			state:         state,
+		}
+	}
+	return scanner.Err()
+}
+
+// hostLittleEndian is set if the host, and thus the kernel printing the socket
+// tables, is little-endian.
+var hostLittleEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
+
+// parseSocketAddr parses an address from a socket table (e.g. 0100007F:1F90)
+// into host:port form. The IP address is printed by the kernel as a sequence
+// of 32-bit words in host byte order.
+func parseSocketAddr(s string) (string, error) {
+	ipHex, portHex, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", fmt.Errorf("bad address %q", s)
+	}
+	ip, err := hex.DecodeString(ipHex)
+	if err != nil || (len(ip) != net.IPv4len && len(ip) != net.IPv6len) {
+		return "", fmt.Errorf("bad address %q", s)
+	}
+	if hostLittleEndian {
+		for i := 0; i < len(ip); i += 4 {
+			ip[i], ip[i+1], ip[i+2], ip[i+3] = ip[i+3], ip[i+2], ip[i+1], ip[i]
+		}
+	}
+	port, err := strconv.ParseUint(portHex, 16, 16)
+	if err != nil {
+		return "", fmt.Errorf("bad address %q", s)
+	}
+	return net.JoinHostPort(net.IP(ip).String(), strconv.FormatUint(port, 10)), nil
+}
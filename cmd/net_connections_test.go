@@ -0,0 +1,81 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSocketAddr(t *testing.T) {
+	if !hostLittleEndian {
+		t.Skip("the test data was produced on a little-endian host")
+	}
+	for _, tc := range []struct {
+		in  string
+		exp string
+		err bool
+	}{
+		{in: "0100007F:1F90", exp: "127.0.0.1:8080"},
+		{in: "00000000:0000", exp: "0.0.0.0:0"},
+		{in: "0A01A8C0:C350", exp: "192.168.1.10:50000"},
+		{in: "00000000000000000000000001000000:0016", exp: "[::1]:22"},
+		{in: "000080FE000000000000000001000000:01BB", exp: "[fe80::1]:443"},
+		{in: "0000000000000000FFFF00000100007F:1F90", exp: "127.0.0.1:8080"},
+		{in: "0100007F", err: true},
+		{in: "0100007:1F90", err: true},
+		{in: "0100007F00:1F90", err: true},
+		{in: "0100007F:1F9000", err: true},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			res, err := parseSocketAddr(tc.in)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected error, got %s", res)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res != tc.exp {
+				t.Fatalf("expected %s, got %s", tc.exp, res)
+			}
+		})
+	}
+}
+
+func TestParseSocketTable(t *testing.T) {
+	if !hostLittleEndian {
+		t.Skip("the test data was produced on a little-endian host")
+	}
+	const tcp = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1000        0 1001 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:1F90 0100007F:D431 01 00000000:00000000 00:00000000 00000000  1000        0 1002 1 0000000000000000 20 4 30 10 -1
+`
+	const tcp6 = `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000000000000000000000000000:0016 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 2001 1 0000000000000000 100 0 0 10 0
+   1: 00000000000000000000000001000000:0016 00000000000000000000000001000000:E2A4 01 00000000:00000000 02:0009A5E3 00000000     0        0 2002 2 0000000000000000 20 4 31 10 -1
+   2: 00000000000000000000000001000000:0017 00000000000000000000000001000000:E2A5 0F 00000000:00000000 02:0009A5E3 00000000     0        0 2003 2 0000000000000000 20 4 31 10 -1
+`
+	res := make(map[uint64]socket)
+	if err := parseSocketTable(strings.NewReader(tcp), "tcp", res); err != nil {
+		t.Fatal(err)
+	}
+	if err := parseSocketTable(strings.NewReader(tcp6), "tcp6", res); err != nil {
+		t.Fatal(err)
+	}
+	exp := map[uint64]socket{
+		1001: {protocol: "tcp", localAddress: "127.0.0.1:8080", remoteAddress: "0.0.0.0:0", state: "LISTEN"},
+		1002: {protocol: "tcp", localAddress: "127.0.0.1:8080", remoteAddress: "127.0.0.1:54321", state: "ESTABLISHED"},
+		2001: {protocol: "tcp6", localAddress: "[::]:22", remoteAddress: "[::]:0", state: "LISTEN"},
+		2002: {protocol: "tcp6", localAddress: "[::1]:22", remoteAddress: "[::1]:58020", state: "ESTABLISHED"},
+		2003: {protocol: "tcp6", localAddress: "[::1]:23", remoteAddress: "[::1]:58021", state: "state 15"},
+	}
+	if !reflect.DeepEqual(res, exp) {
+		t.Fatalf("expected %+v, got %+v", exp, res)
+	}
+
+	if err := parseSocketTable(strings.NewReader(tcp+"   2: 0100007F:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1000        0 x\n"), "tcp", res); err == nil {
+		t.Fatal("expected an error for a bad inode")
+	}
+}
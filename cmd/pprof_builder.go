@@ -24,9 +24,13 @@ type pprofBuilder struct {
 	// locationMap keeps track of all locations in profile, mapping (function
 	// name, pc offset) to *Location.
 	locationMap map[locationKey]*profile.Location
+	// funcAddrs maps the complete names of functions that don't have DWARF info
+	// to their entry addresses. The locations in these functions use real
+	// addresses.
+	funcAddrs map[string]uint64
 }
 
-func newPProfBuilder() *pprofBuilder {
+func newPProfBuilder(funcAddrs map[string]uint64) *pprofBuilder {
 	return &pprofBuilder{
 		profile: &profile.Profile{
 			Mapping: []*profile.Mapping{
@@ -44,16 +48,26 @@ func newPProfBuilder() *pprofBuilder {
 		},
 		functionMap: make(map[string]*profile.Function),
 		locationMap: make(map[locationKey]*profile.Location),
+		funcAddrs:   funcAddrs,
 	}
 }
 
-func (b *pprofBuilder) addSample(calls []pp.Call, gIDs []int) {
+// addSample adds a sample for the goroutines with the given IDs, which share
+// the stack calls. strLabels are attached to the sample as string labels.
+func (b *pprofBuilder) addSample(calls []pp.Call, gIDs []int, strLabels map[string]string) {
 	labels := map[string][]int64{
 		agentrpc.GoroutineIDLabel: make([]int64, len(gIDs)),
 	}
 	for i, gID := range gIDs {
 		labels[agentrpc.GoroutineIDLabel][i] = int64(gID)
 	}
+	var label map[string][]string
+	if len(strLabels) > 0 {
+		label = make(map[string][]string, len(strLabels))
+		for k, v := range strLabels {
+			label[k] = []string{v}
+		}
+	}
 
 	var locs []*profile.Location
 	for _, call := range calls {
@@ -64,6 +78,7 @@ func (b *pprofBuilder) addSample(calls []pp.Call, gIDs []int) {
 		Location: locs,
 		Value:    nil,
 		NumLabel: labels,
+		Label:    label,
 	}
 	b.profile.Sample = append(b.profile.Sample, sample)
 }
@@ -86,10 +101,14 @@ func (b *pprofBuilder) getOrAddLocation(call pp.Call) *profile.Location {
 		return id
 	}
 
+	addr := hash // HACK
+	if entry, ok := b.funcAddrs[call.Func.Complete]; ok {
+		addr = entry + uint64(call.PCOffset)
+	}
 	location := &profile.Location{
 		ID:      hash,
 		Mapping: b.profile.Mapping[0],
-		Address: hash, // HACK
+		Address: addr,
 		Line: []profile.Line{{
 			Function: b.getOrAddFunction(call),
 			Line:     int64(call.Line),
@@ -109,7 +128,13 @@ func (b *pprofBuilder) getOrAddFunction(call pp.Call) *profile.Function {
 	// For example:
 	// github.com/cockroachdb/pebble/record.NewLogWriter           -> record.NewLogWriter
 	// github.com/cockroachdb/pebble/record.(*LogWriter).flushLoop -> record.(*LogWriter).flushLoop
-	funcName := call.Func.DirName + "." + call.Func.Name
+	//
+	// Synthetic frames (e.g. the markers for truncated stacks) don't have a
+	// package.
+	funcName := call.Func.Name
+	if call.Func.DirName != "" {
+		funcName = call.Func.DirName + "." + call.Func.Name
+	}
 	h := fnv.New64()
 	h.Write([]byte(funcName))
 	hash := h.Sum64()
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-delve/delve/service/api"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+// pprofLabelsExpr evaluates, in the scope of a goroutine, to a pointer to the
+// goroutine's pprof labels. The labels field of the runtime's g struct is an
+// unsafe.Pointer to a runtime/pprof.labelMap; it is nil for goroutines without
+// labels. Delve only understands the labels as long as they are stored in a
+// map, so the labels are read directly from the runtime.
+const pprofLabelsExpr = `(*"runtime/pprof.labelMap")(runtime.curg.labels)`
+
+// pprofLabelsLoadConfig is used for reading the pprof labels of a goroutine.
+// Since Go 1.24, the labels are stored in a list nested in a struct nested in
+// the labelMap, hence the recursion depth.
+var pprofLabelsLoadConfig = api.LoadConfig{
+	FollowPointers:     true,
+	MaxVariableRecurse: 4,
+	MaxStringLen:       256,
+	MaxArrayValues:     64,
+	MaxStructFields:    -1,
+}
+
+// readPProfLabels reads the pprof labels of the goroutines in snap. It returns
+// the labels of the goroutines that have any, keyed by goroutine ID, and the
+// number of goroutines whose labels could not be read; these goroutines are
+// skipped. The target needs to be halted.
+func (s *grpcServer) readPProfLabels(snap *pp.Snapshot) (map[int]map[string]string, int, error) {
+	res := make(map[int]map[string]string)
+	failed := 0
+	for _, g := range snap.Goroutines {
+		ptr, err := s.client.EvalVariable(api.EvalScope{GoroutineID: int64(g.ID)}, pprofLabelsExpr, pprofLabelsLoadConfig)
+		if err != nil {
+			if !isEvalError(err) {
+				return nil, 0, fmt.Errorf("failed to read the pprof labels of goroutine %d: %w", g.ID, err)
+			}
+			failed++
+			continue
+		}
+		if len(ptr.Children) == 0 || ptr.Children[0].Addr == 0 {
+			continue
+		}
+		if labels := decodePProfLabels(&ptr.Children[0]); len(labels) > 0 {
+			res[g.ID] = labels
+		}
+	}
+	return res, failed, nil
+}
+
+// decodePProfLabels returns the labels stored in a runtime/pprof.labelMap.
+func decodePProfLabels(m *api.Variable) map[string]string {
+	res := make(map[string]string)
+	if m.Kind == reflect.Map {
+		// Before Go 1.24, labelMap is a map[string]string. Delve represents
+		// maps as a flat list of alternating keys and values.
+		for i := 0; i+1 < len(m.Children); i += 2 {
+			res[m.Children[i].Value] = m.Children[i+1].Value
+		}
+		return res
+	}
+	// Since Go 1.24, labelMap is a struct embedding a LabelSet, which holds a
+	// list of key/value pairs.
+	list := field(field(m, "LabelSet"), "list")
+	if list == nil {
+		return res
+	}
+	for i := range list.Children {
+		k, v := field(&list.Children[i], "key"), field(&list.Children[i], "value")
+		if k != nil && v != nil {
+			res[k.Value] = v.Value
+		}
+	}
+	return res
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	"github.com/go-delve/delve/service/api"
+)
+
+// pStatuses are the names of the runtime's P statuses (the _P* constants in
+// runtime/runtime2.go), indexed by status.
+var pStatuses = [...]string{
+	"idle",
+	"running",
+	"syscall",
+	"gcstop",
+	"dead",
+}
+
+// gcPhases are the names of the runtime's GC phases (the _GC* constants in
+// runtime/mgc.go), indexed by phase.
+var gcPhases = [...]string{
+	"off",
+	"mark",
+	"mark termination",
+}
+
+// maxProcessors is the maximum number of Ps read from the target.
+const maxProcessors = 1024
+
+// runtimeGlobalsLoadConfig is used for reading the runtime's global variables.
+// The atomic types wrap their value in a struct, so fields nested one level
+// deep are loaded too.
+var runtimeGlobalsLoadConfig = api.LoadConfig{
+	MaxVariableRecurse: 1,
+	MaxStringLen:       64,
+	MaxStructFields:    -1,
+}
+
+// GetRuntimeInfo is part of the SnapshotService interface.
+func (s *grpcServer) GetRuntimeInfo(
+	ctx context.Context, in *agentrpc.GetRuntimeInfoIn,
+) (*agentrpc.GetRuntimeInfoOut, error) {
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	r := &runtimeReader{s: s, globals: make(map[string]*api.Variable)}
+	out := &agentrpc.GetRuntimeInfoOut{}
+	if v := r.global("buildVersion"); v != nil {
+		out.GoVersion = v.Value
+	} else {
+		r.unavailable = append(r.unavailable, "runtime.buildVersion")
+	}
+	out.Sched = &agentrpc.SchedInfo{
+		Gomaxprocs: int32(r.int("gomaxprocs")),
+		IdlePs:     int32(r.int("sched.npidle")),
+		// mnext is the number of Ms created so far; older runtimes call it
+		// mcount.
+		NumMs:      int32(r.int("sched.mnext", "sched.mcount") - r.int("sched.nmfreed")),
+		IdleMs:     int32(r.int("sched.nmidle")),
+		SpinningMs: int32(r.int("sched.nmspinning")),
+		// Since Go 1.25, the global run queue tracks its own size.
+		GlobalRunqueueSize: int32(r.int("sched.runq.size", "sched.runqsize")),
+	}
+	out.Processors = r.processors()
+	out.Gc = &agentrpc.GCInfo{
+		Cycles:          uint32(r.int("work.cycles")),
+		NumGc:           uint32(r.int("memstats.numgc")),
+		GcPercent:       int32(r.int("gcController.gcPercent", "gcpercent")),
+		LastGcUnixNanos: r.int("memstats.last_gc_unix"),
+		PauseTotalNanos: uint64(r.int("memstats.pause_total_ns")),
+		// Before Go 1.18, the GC's pacing state lived in memstats.
+		HeapLiveBytes:   uint64(r.int("gcController.heapLive", "memstats.heap_live")),
+		HeapMarkedBytes: uint64(r.int("gcController.heapMarked", "memstats.heap_marked")),
+	}
+	// Phase is left empty if the phase can't be read, instead of reporting the
+	// zero phase.
+	if phase, ok := r.lookupInt("gcphase"); ok {
+		if phase >= 0 && phase < int64(len(gcPhases)) {
+			out.Gc.Phase = gcPhases[phase]
+		} else {
+			out.Gc.Phase = fmt.Sprintf("phase %d", phase)
+		}
+	}
+	// Recent runtimes track the heap's usage in the GC controller; older ones
+	// track it in memstats.
+	out.MemStats = &agentrpc.MemStats{
+		HeapInUseBytes:    uint64(r.int("gcController.heapInUse", "memstats.heapInUse", "memstats.heap_inuse")),
+		HeapFreeBytes:     uint64(r.int("gcController.heapFree", "memstats.heapFree")),
+		HeapReleasedBytes: uint64(r.int("gcController.heapReleased", "memstats.heapReleased", "memstats.heap_released")),
+		StacksSysBytes:    uint64(r.int("memstats.stacks_sys")),
+		TotalAllocBytes:   uint64(r.int("gcController.totalAlloc", "memstats.totalAlloc")),
+		TotalFreeBytes:    uint64(r.int("gcController.totalFree", "memstats.totalFree")),
+	}
+	sort.Strings(r.unavailable)
+	out.UnavailableFields = r.unavailable
+	return out, nil
+}
+
+// runtimeReader reads the runtime's global variables. The runtime's structs
+// change between Go versions, so fields are looked up by name, with fallbacks
+// for older versions. The target needs to be halted.
+type runtimeReader struct {
+	s *grpcServer
+	// globals caches the global variables that were read, keyed by name. A nil
+	// entry means that the variable doesn't exist in the target.
+	globals map[string]*api.Variable
+	// unavailable are the fields that could not be read.
+	unavailable []string
+}
+
+// global returns the runtime's global variable with the given name, or nil.
+func (r *runtimeReader) global(name string) *api.Variable {
+	if v, ok := r.globals[name]; ok {
+		return v
+	}
+	v, err := r.s.client.EvalVariable(api.EvalScope{GoroutineID: -1}, "runtime."+name, runtimeGlobalsLoadConfig)
+	if err != nil || v.Unreadable != "" {
+		v = nil
+	}
+	r.globals[name] = v
+	return v
+}
+
+// int returns the integer value of the first of the paths that exists in the
+// target. A path is the name of a global variable, optionally followed by
+// field names separated by dots. If none of the paths exist, the first one is
+// recorded as unavailable and 0 is returned.
+func (r *runtimeReader) int(paths ...string) int64 {
+	n, _ := r.lookupInt(paths...)
+	return n
+}
+
+// lookupInt is like int, but also returns false if none of the paths exist.
+func (r *runtimeReader) lookupInt(paths ...string) (int64, bool) {
+	for _, p := range paths {
+		name, fields, _ := strings.Cut(p, ".")
+		if n, ok := intPath(r.global(name), fields); ok {
+			return n, true
+		}
+	}
+	r.unavailable = append(r.unavailable, "runtime."+paths[0])
+	return 0, false
+}
+
+// processors reads the state of the Ps.
+func (r *runtimeReader) processors() []*agentrpc.ProcessorInfo {
+	allp, err := r.s.client.EvalVariable(api.EvalScope{GoroutineID: -1}, "runtime.allp", api.LoadConfig{
+		FollowPointers:     true,
+		MaxVariableRecurse: 1,
+		MaxArrayValues:     maxProcessors,
+		MaxStructFields:    -1,
+	})
+	if err != nil || allp.Unreadable != "" {
+		r.unavailable = append(r.unavailable, "runtime.allp")
+		return nil
+	}
+	missing := make(map[string]struct{})
+	pInt := func(p *api.Variable, name string) int64 {
+		n, ok := intPath(p, name)
+		if !ok {
+			missing[name] = struct{}{}
+		}
+		return n
+	}
+	var res []*agentrpc.ProcessorInfo
+	for i := range allp.Children {
+		ptr := &allp.Children[i]
+		if len(ptr.Children) == 0 || ptr.Children[0].Addr == 0 {
+			continue
+		}
+		p := &ptr.Children[0]
+		info := &agentrpc.ProcessorInfo{
+			Id:          int32(pInt(p, "id")),
+			Schedtick:   uint32(pInt(p, "schedtick")),
+			Syscalltick: uint32(pInt(p, "syscalltick")),
+			HasM:        pInt(p, "m") != 0,
+		}
+		status := pInt(p, "status")
+		if status >= 0 && status < int64(len(pStatuses)) {
+			info.Status = pStatuses[status]
+		} else {
+			info.Status = fmt.Sprintf("status %d", status)
+		}
+		// The local run queue is a ring buffer; runnext holds one more
+		// goroutine, which runs next.
+		n := uint32(pInt(p, "runqtail")) - uint32(pInt(p, "runqhead"))
+		if pInt(p, "runnext") != 0 {
+			n++
+		}
+		info.RunqueueSize = int32(n)
+		res = append(res, info)
+	}
+	for name := range missing {
+		r.unavailable = append(r.unavailable, "runtime.allp[]."+name)
+	}
+	return res
+}
+
+// intPath returns the integer value of v's field at the given path of field
+// names separated by dots; an empty path designates v itself. The values of
+// the atomic types are unwrapped.
+func intPath(v *api.Variable, path string) (int64, bool) {
+	if v == nil {
+		return 0, false
+	}
+	if path != "" {
+		for _, name := range strings.Split(path, ".") {
+			if v = field(v, name); v == nil {
+				return 0, false
+			}
+		}
+	}
+	if v.Kind == reflect.Struct {
+		// The runtime's atomic types store their value in a field called
+		// value, and the sync/atomic ones in a field called v.
+		w := field(v, "value")
+		if w == nil {
+			w = field(v, "v")
+		}
+		if w == nil {
+			return 0, false
+		}
+		v = w
+	}
+	if v.Unreadable != "" {
+		return 0, false
+	}
+	return parseInt(v.Value)
+}
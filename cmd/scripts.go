@@ -0,0 +1,123 @@
+package main
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+)
+
+var scriptsDirFlag = flag.String("scripts-dir", "",
+	"directory with Starlark scripts overriding the ones embedded in the agent")
+
+// embeddedScripts are the Starlark scripts built into the agent.
+//
+//go:embed scripts/*.star
+var embeddedScripts embed.FS
+
+// The names of the scripts used by the agent.
+const (
+	walkStacksScript     = "walk_stacks.star"
+	spawnTreeScript      = "spawn_tree.star"
+	findReferencesScript = "find_references.star"
+	chanWaitsScript      = "chan_waits.star"
+)
+
+// script is a Starlark script that the agent runs in the target.
+type script struct {
+	name   string
+	source string
+	// version identifies the script's source; it is a prefix of the source's
+	// SHA-256 hash.
+	version string
+	// path is the file the script was loaded from, if it was loaded from the
+	// override directory. Empty for embedded scripts.
+	path string
+}
+
+func newScript(name string, source []byte, path string) *script {
+	h := sha256.Sum256(source)
+	return &script{
+		name:    name,
+		source:  string(source),
+		version: hex.EncodeToString(h[:])[:12],
+		path:    path,
+	}
+}
+
+func (sc *script) info() *agentrpc.ScriptInfo {
+	return &agentrpc.ScriptInfo{
+		Name:       sc.name,
+		Version:    sc.version,
+		Overridden: sc.path != "",
+		Path:       sc.path,
+	}
+}
+
+// scriptLibrary contains the scripts available to the agent, keyed by name.
+// The scripts are loaded once, when the agent starts, so they don't change
+// under a running agent.
+type scriptLibrary map[string]*script
+
+// loadScripts loads the embedded scripts and, if overrideDir is not empty, the
+// *.star files in overrideDir. A file in overrideDir replaces the embedded
+// script with the same name.
+func loadScripts(overrideDir string) (scriptLibrary, error) {
+	lib := make(scriptLibrary)
+	entries, err := fs.ReadDir(embeddedScripts, "scripts")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		src, err := embeddedScripts.ReadFile("scripts/" + e.Name())
+		if err != nil {
+			return nil, err
+		}
+		lib[e.Name()] = newScript(e.Name(), src, "")
+	}
+
+	if overrideDir == "" {
+		return lib, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(overrideDir, "*.star"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		name := filepath.Base(path)
+		lib[name] = newScript(name, src, path)
+	}
+	return lib, nil
+}
+
+// get returns the script with the given name.
+func (lib scriptLibrary) get(name string) (*script, error) {
+	sc, ok := lib[name]
+	if !ok {
+		return nil, fmt.Errorf("script %s not found", name)
+	}
+	return sc, nil
+}
+
+// infos returns information about the scripts in the library, sorted by name.
+func (lib scriptLibrary) infos() []*agentrpc.ScriptInfo {
+	res := make([]*agentrpc.ScriptInfo, 0, len(lib))
+	for _, sc := range lib {
+		res = append(res, sc.info())
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	"github.com/go-delve/delve/service/api"
+)
+
+const (
+	// defaultAncestorDepth is the number of frames read for every ancestor of a
+	// goroutine, unless the request specifies otherwise.
+	defaultAncestorDepth = 20
+	// maxAncestors and maxAncestorDepth are the upper bounds for the number of
+	// ancestors and ancestor frames that GetSpawnTree requests can ask for.
+	maxAncestors     = 100
+	maxAncestorDepth = 100
+)
+
+// spawnInfo is the information about a goroutine's creation, as returned by
+// spawn_tree.star.
+type spawnInfo struct {
+	// Parent is the ID of the goroutine's parent, if the runtime tracks it.
+	Parent    int64          `json:"Parent"`
+	Ancestors []ancestorInfo `json:"Ancestors"`
+}
+
+// ancestorInfo is an ancestor of a goroutine, as recorded by the runtime with
+// GODEBUG=tracebackancestors.
+type ancestorInfo struct {
+	ID int64 `json:"ID"`
+	// Stack is the ancestor's stack at the time when it created its child.
+	Stack []struct {
+		Function string `json:"Function"`
+		File     string `json:"File"`
+		Line     int64  `json:"Line"`
+	} `json:"Stack"`
+	Unreadable string `json:"Unreadable"`
+}
+
+// GetSpawnTree is part of the SnapshotService interface.
+func (s *grpcServer) GetSpawnTree(
+	ctx context.Context, in *agentrpc.GetSpawnTreeIn,
+) (*agentrpc.GetSpawnTreeOut, error) {
+	numAncestors := int(in.MaxAncestors)
+	if numAncestors < 0 {
+		numAncestors = 0
+	} else if numAncestors > maxAncestors {
+		numAncestors = maxAncestors
+	}
+	ancestorDepth := int(in.MaxAncestorDepth)
+	if ancestorDepth <= 0 {
+		ancestorDepth = defaultAncestorDepth
+	} else if ancestorDepth > maxAncestorDepth {
+		ancestorDepth = maxAncestorDepth
+	}
+
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	gs, err := s.listGoroutines()
+	if err != nil {
+		return nil, err
+	}
+	parentIDs := len(gs) > 0 && s.tracksParentGoroutines(gs[0])
+	var infos map[int64]spawnInfo
+	if parentIDs || numAncestors > 0 {
+		infos, err = s.readSpawnInfo(parentIDs, numAncestors, ancestorDepth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := buildSpawnTree(gs, infos)
+	out.ParentsKnown = parentIDs || numAncestors > 0
+	out.Sites = creationSites(gs)
+	out.NumGoroutines = int32(len(gs))
+	return out, nil
+}
+
+// readSpawnInfo runs spawn_tree.star in order to read the parents and the
+// ancestors of all the goroutines. The target needs to be halted.
+func (s *grpcServer) readSpawnInfo(
+	parentIDs bool, numAncestors, ancestorDepth int,
+) (map[int64]spawnInfo, error) {
+	sc, err := s.scripts.get(spawnTreeScript)
+	if err != nil {
+		return nil, err
+	}
+	script, err := withParams(sc.source, scriptParams{
+		"parent_ids":         parentIDs,
+		"max_ancestors":      numAncestors,
+		"max_ancestor_depth": ancestorDepth,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.client.ExecScript(script)
+	if err != nil {
+		return nil, fmt.Errorf("executing script failed: %w\nOutput:%s", err, res.Output)
+	}
+	unquoted, err := strconv.Unquote(res.Val)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse script results: %w", err)
+	}
+	var infos map[int64]spawnInfo
+	if err := json.Unmarshal([]byte(unquoted), &infos); err != nil {
+		return nil, fmt.Errorf("failed to parse script results: %w", err)
+	}
+	return infos, nil
+}
+
+// buildSpawnTree links the goroutines in gs to their parents. infos contains
+// the goroutines' parents and ancestors, keyed by goroutine ID. Ancestors that
+// have exited become nodes of their own, so that their live descendants are
+// still grouped together.
+func buildSpawnTree(gs []*api.Goroutine, infos map[int64]spawnInfo) *agentrpc.GetSpawnTreeOut {
+	nodes := make(map[int64]*agentrpc.SpawnNode, len(gs))
+	for _, g := range gs {
+		node := &agentrpc.SpawnNode{
+			GoroutineId: g.ID,
+			CreatedBy:   locationToProto(g.GoStatementLoc),
+		}
+		if fn := g.StartLoc.Function; fn != nil {
+			node.StartFunction = fn.Name()
+		}
+		nodes[g.ID] = node
+	}
+
+	parents := make(map[int64]int64)
+	for _, g := range gs {
+		info := infos[g.ID]
+		if info.Parent != 0 {
+			parents[g.ID] = info.Parent
+		}
+		// The first ancestor is the goroutine's parent, the second one is the
+		// parent's parent, and so on.
+		child := g.ID
+		for _, a := range info.Ancestors {
+			if _, ok := parents[child]; !ok {
+				parents[child] = a.ID
+			}
+			if _, ok := nodes[a.ID]; !ok {
+				node := &agentrpc.SpawnNode{GoroutineId: a.ID, Exited: true}
+				for _, f := range a.Stack {
+					node.AncestorStack = append(node.AncestorStack, &agentrpc.StackFrame{
+						Function: f.Function,
+						File:     f.File,
+						Line:     f.Line,
+					})
+				}
+				nodes[a.ID] = node
+			}
+			child = a.ID
+		}
+	}
+
+	out := &agentrpc.GetSpawnTreeOut{}
+	for id, node := range nodes {
+		parent, ok := nodes[parents[id]]
+		if !ok || parents[id] == id {
+			out.Roots = append(out.Roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+	sortSpawnNodes(out.Roots)
+	for _, root := range out.Roots {
+		countDescendants(root)
+	}
+	return out
+}
+
+func sortSpawnNodes(nodes []*agentrpc.SpawnNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].GoroutineId < nodes[j].GoroutineId
+	})
+	for _, n := range nodes {
+		sortSpawnNodes(n.Children)
+	}
+}
+
+// countDescendants populates the NumDescendants field of node and of all the
+// nodes in its subtree. Returns the number of nodes in the subtree.
+func countDescendants(node *agentrpc.SpawnNode) int32 {
+	var n int32
+	for _, c := range node.Children {
+		n += countDescendants(c)
+	}
+	node.NumDescendants = n
+	return n + 1
+}
+
+// creationSites groups the goroutines in gs by the go statements that created
+// them. The sites are ordered by decreasing number of goroutines.
+func creationSites(gs []*api.Goroutine) []*agentrpc.CreationSite {
+	bySite := make(map[string]*agentrpc.CreationSite)
+	var sites []*agentrpc.CreationSite
+	for _, g := range gs {
+		loc := g.GoStatementLoc
+		if loc.PC == 0 {
+			// The main goroutine and the goroutines started by the runtime
+			// without a go statement.
+			continue
+		}
+		k := fmt.Sprintf("%#x", loc.PC)
+		site, ok := bySite[k]
+		if !ok {
+			site = &agentrpc.CreationSite{
+				Location:       locationToProto(loc),
+				StartFunctions: make(map[string]int32),
+			}
+			bySite[k] = site
+			sites = append(sites, site)
+		}
+		site.NumGoroutines++
+		if fn := g.StartLoc.Function; fn != nil {
+			site.StartFunctions[fn.Name()]++
+		}
+	}
+	sort.Slice(sites, func(i, j int) bool {
+		if sites[i].NumGoroutines != sites[j].NumGoroutines {
+			return sites[i].NumGoroutines > sites[j].NumGoroutines
+		}
+		a, b := sites[i].Location, sites[j].Location
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+	return sites
+}
+
+func locationToProto(loc api.Location) *agentrpc.StackFrame {
+	frame := &agentrpc.StackFrame{File: loc.File, Line: int64(loc.Line)}
+	if loc.Function != nil {
+		frame.Function = loc.Function.Name()
+	}
+	return frame
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"debug/elf"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// elfSymbolizer resolves PCs in the target process to ELF symbols. It is used
+// for frames that Delve doesn't have DWARF information for (assembly
+// trampolines, cgo code, etc.).
+//
+// The symbolizer reads the target's memory mappings from /proc, so it only
+// works when the agent runs on the same machine as the target.
+type elfSymbolizer struct {
+	mappings []memMapping
+	// files caches the symbols of the ELF files that were opened, keyed by path.
+	// A nil entry means that the file could not be read.
+	files map[string]*elfFile
+}
+
+// memMapping is a file-backed mapping of the target process, as described by
+// /proc/<pid>/maps.
+type memMapping struct {
+	start, end uint64
+	// offset is the offset in the file corresponding to start.
+	offset uint64
+	path   string
+}
+
+type elfFile struct {
+	progs []elf.ProgHeader
+	// syms are the file's function symbols, sorted by address.
+	syms []elf.Symbol
+}
+
+// newELFSymbolizer returns a symbolizer for the process with the given pid.
+func newELFSymbolizer(pid int) (*elfSymbolizer, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/maps", pid))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s := &elfSymbolizer{files: make(map[string]*elfFile)}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// Lines look like:
+		// 00400000-00452000 r-xp 00000000 08:02 173521      /usr/bin/dbus-daemon
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 || !strings.HasPrefix(fields[5], "/") {
+			continue
+		}
+		addrs := strings.SplitN(fields[0], "-", 2)
+		if len(addrs) != 2 {
+			continue
+		}
+		start, err1 := strconv.ParseUint(addrs[0], 16, 64)
+		end, err2 := strconv.ParseUint(addrs[1], 16, 64)
+		offset, err3 := strconv.ParseUint(fields[2], 16, 64)
+		if err1 != nil || err2 != nil || err3 != nil {
+			continue
+		}
+		s.mappings = append(s.mappings, memMapping{
+			start:  start,
+			end:    end,
+			offset: offset,
+			path:   fields[5],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// lookup returns the name and the address of the function symbol containing
+// pc. Returns false if no such symbol is found.
+func (s *elfSymbolizer) lookup(pc uint64) (name string, entry uint64, ok bool) {
+	for _, m := range s.mappings {
+		if pc < m.start || pc >= m.end {
+			continue
+		}
+		file := s.file(m.path)
+		if file == nil {
+			return "", 0, false
+		}
+		// Translate pc to an address in the file's address space, by way of the
+		// file offset.
+		fileOffset := pc - m.start + m.offset
+		for _, p := range file.progs {
+			if p.Type != elf.PT_LOAD || fileOffset < p.Off || fileOffset >= p.Off+p.Filesz {
+				continue
+			}
+			addr := fileOffset - p.Off + p.Vaddr
+			// Find the last symbol starting at or before addr.
+			i := sort.Search(len(file.syms), func(i int) bool {
+				return file.syms[i].Value > addr
+			}) - 1
+			if i < 0 {
+				return "", 0, false
+			}
+			sym := file.syms[i]
+			if sym.Size != 0 && addr >= sym.Value+sym.Size {
+				return "", 0, false
+			}
+			return sym.Name, pc - (addr - sym.Value), true
+		}
+		return "", 0, false
+	}
+	return "", 0, false
+}
+
+// file returns the symbols of the ELF file at path, reading them if necessary.
+// Returns nil if the file cannot be read.
+func (s *elfSymbolizer) file(path string) *elfFile {
+	if f, ok := s.files[path]; ok {
+		return f
+	}
+	s.files[path] = nil
+	ef, err := elf.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer ef.Close()
+
+	f := &elfFile{}
+	for _, p := range ef.Progs {
+		f.progs = append(f.progs, p.ProgHeader)
+	}
+	// Stripped binaries and shared libraries might only have dynamic symbols.
+	syms, _ := ef.Symbols()
+	dynSyms, _ := ef.DynamicSymbols()
+	for _, sym := range append(syms, dynSyms...) {
+		if elf.ST_TYPE(sym.Info) != elf.STT_FUNC || sym.Value == 0 {
+			continue
+		}
+		f.syms = append(f.syms, sym)
+	}
+	sort.Slice(f.syms, func(i, j int) bool {
+		return f.syms[i].Value < f.syms[j].Value
+	})
+	s.files[path] = f
+	return f
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"debug/elf"
+	"os"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+)
+
+func TestELFSymbolizerLookup(t *testing.T) {
+	sym, err := newELFSymbolizer(os.Getpid())
+	if err != nil {
+		t.Skipf("cannot read the memory mappings: %v", err)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ef, err := elf.Open(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ef.Close()
+
+	check := func(t *testing.T, name string, entry uint64) {
+		t.Helper()
+		for _, pc := range []uint64{entry, entry + 1} {
+			n, e, ok := sym.lookup(pc)
+			if !ok {
+				t.Fatalf("failed to look up pc 0x%x", pc)
+			}
+			if n != name || e != entry {
+				t.Fatalf("expected %s at 0x%x, got %s at 0x%x", name, entry, n, e)
+			}
+		}
+	}
+	t.Run("function", func(t *testing.T) {
+		// go test strips the symbol table, unless the test binary is built
+		// with -c.
+		if _, err := ef.Symbols(); err != nil {
+			t.Skipf("the test binary has no symbol table: %v", err)
+		}
+		pc := reflect.ValueOf(parseStacks).Pointer()
+		check(t, runtime.FuncForPC(pc).Name(), uint64(pc))
+	})
+	t.Run("dynamic symbol", func(t *testing.T) {
+		// The addresses of the symbols of a non-PIE executable are the
+		// addresses they're loaded at. Binaries using cgo export a few
+		// functions, like crosscall2.
+		if ef.Type != elf.ET_EXEC {
+			t.Skip("the test binary is position-independent")
+		}
+		dynSyms, _ := ef.DynamicSymbols()
+		for _, s := range dynSyms {
+			if elf.ST_TYPE(s.Info) == elf.STT_FUNC && s.Value != 0 {
+				check(t, s.Name, s.Value)
+				return
+			}
+		}
+		t.Skip("the test binary exports no functions")
+	})
+	if name, _, ok := sym.lookup(0); ok {
+		t.Fatalf("expected no symbol for pc 0, got %s", name)
+	}
+}
+
+func TestUnknownFramesKeepFrameIndexes(t *testing.T) {
+	// The stack contains a frame without a function, like walk_stacks.star
+	// produces for assembly and cgo code, before the frame of interest.
+	snap, err := parseStacks(map[int]string{1: "goroutine 1 [waiting]:\n" +
+		"runtime.gopark()\n\t/go/src/runtime/proc.go:10 +0x10\n" +
+		"<unknown pc 0x2000>()\n\t??:0 +0x0\n" +
+		"github.com/cockroachdb/cockroach/pkg/sql.(*connExecutor).execStmt()\n\t/src/sql/conn_executor_exec.go:276 +0x40\n" +
+		"runtime.goexit()\n\t/go/src/runtime/asm_amd64.s:1598 +0x1\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := snap.Goroutines[0].Stack.Calls
+	if len(calls) != 4 {
+		t.Fatalf("expected 4 frames, got %d", len(calls))
+	}
+	symbolizeUnknownFrames(snap, map[int]map[int]uint64{1: {1: 0x2000}}, nil /* sym */)
+	if name := calls[1].Func.Complete; name != "<unknown pc 0x2000>" {
+		t.Fatalf("expected the unknown frame to be kept, got %s", name)
+	}
+
+	matchers, err := newFrameMatchers([]*agentrpc.FrameSpec{{FuncName: "execStmt", Expressions: []string{"stmt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tasks := frameSpecTasks(matchers, snap, map[int][]int{1: {0, 1, 2, 3}})
+	if len(tasks) != 1 {
+		t.Fatalf("expected 1 task, got %d", len(tasks))
+	}
+	task := tasks[0]
+	if task.FrameIdx != 2 || task.OutputFrameIdx != 2 {
+		t.Fatalf("expected frame 2, got frame %d and output frame %d", task.FrameIdx, task.OutputFrameIdx)
+	}
+	fd := frameDataToProto(task.GoroutineID, task.OutputFrameIdx, []CapturedExpr{{Expr: task.Expr, Val: "1"}})
+	if f := calls[fd.FrameIdx].Func.Complete; f != "github.com/cockroachdb/cockroach/pkg/sql.(*connExecutor).execStmt" {
+		t.Fatalf("frame data points to %s", f)
+	}
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+// syncWaitFunc describes a function that goroutines blocked on a
+// synchronization primitive are blocked in.
+type syncWaitFunc struct {
+	kind agentrpc.SyncPrimitive_Kind
+	// expr is the function's argument pointing to the primitive.
+	expr string
+	// writer is set for RWMutex.Lock.
+	writer bool
+}
+
+// syncWaitFuncs are the functions that goroutines blocked on a synchronization
+// primitive are blocked in.
+var syncWaitFuncs = map[string]syncWaitFunc{
+	"sync.(*WaitGroup).Wait": {kind: agentrpc.SyncPrimitive_WAIT_GROUP, expr: "wg"},
+	"sync.(*Cond).Wait":      {kind: agentrpc.SyncPrimitive_COND, expr: "c"},
+	"sync.(*RWMutex).RLock":  {kind: agentrpc.SyncPrimitive_RW_MUTEX, expr: "rw"},
+	"sync.(*RWMutex).Lock":   {kind: agentrpc.SyncPrimitive_RW_MUTEX, expr: "rw", writer: true},
+	// All the runtime semaphores are acquired through semacquire1, including
+	// the ones of the primitives above.
+	"runtime.semacquire1": {kind: agentrpc.SyncPrimitive_SEMAPHORE, expr: "addr"},
+}
+
+// rwmutexMaxReaders is the bias that RWMutex.Lock subtracts from the
+// readerCount in order to announce a pending writer.
+const rwmutexMaxReaders = 1 << 30
+
+// syncLoadConfig is used for reading the primitives from the waiters' frames.
+// The sync/atomic types wrap the primitives' counters in a struct, so two
+// levels are needed.
+var syncLoadConfig = loadConfig{
+	FollowPointers:     true,
+	MaxVariableRecurse: 2,
+	MaxStructFields:    10,
+}
+
+// GetSyncWaiters is part of the SnapshotService interface.
+func (s *grpcServer) GetSyncWaiters(
+	ctx context.Context, in *agentrpc.GetSyncWaitersIn,
+) (*agentrpc.GetSyncWaitersOut, error) {
+	resume, err := s.halter.halt()
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	walk, err := s.walkStacks(walkOptions{maxDepth: in.MaxStackDepth})
+	if err != nil {
+		return nil, err
+	}
+	var tasks []evalTask
+	for _, g := range walk.stacks.Goroutines {
+		if !isBlocked(g.State) {
+			continue
+		}
+		i, ok := syncWaitFrame(g.Stack.Calls)
+		if !ok {
+			continue
+		}
+		fn := g.Stack.Calls[i].Func.Complete
+		tasks = append(tasks, evalTask{
+			GoroutineID:    g.ID,
+			FrameIdx:       walk.FrameIndexes[g.ID][i],
+			OutputFrameIdx: i,
+			FuncName:       fn,
+			Expr:           syncWaitFuncs[fn].expr,
+			LoadConfig:     syncLoadConfig,
+		})
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].GoroutineID < tasks[j].GoroutineID
+	})
+	captured, err := s.evalExprs(tasks, nil /* typeSpecs */)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &agentrpc.GetSyncWaitersOut{}
+	byAddr := make(map[uint64]*agentrpc.SyncPrimitive)
+	for i, task := range tasks {
+		c := captured[i]
+		if c.Err != "" || c.Structured == nil || c.Structured.PointerAddr == 0 {
+			out.NumUnresolvedWaiters++
+			continue
+		}
+		wf := syncWaitFuncs[task.FuncName]
+		addr := c.Structured.PointerAddr
+		p, ok := byAddr[addr]
+		if !ok {
+			p = &agentrpc.SyncPrimitive{Kind: wf.kind, Address: addr}
+			decodeSyncState(p, c.Structured)
+			byAddr[addr] = p
+			out.Primitives = append(out.Primitives, p)
+		}
+		if wf.writer {
+			p.WriterIds = append(p.WriterIds, int64(task.GoroutineID))
+		} else {
+			p.WaiterIds = append(p.WaiterIds, int64(task.GoroutineID))
+		}
+	}
+	sort.SliceStable(out.Primitives, func(i, j int) bool {
+		a, b := out.Primitives[i], out.Primitives[j]
+		return len(a.WaiterIds)+len(a.WriterIds) > len(b.WaiterIds)+len(b.WriterIds)
+	})
+	return out, nil
+}
+
+// syncWaitFrame returns the index of the frame in calls identifying the
+// primitive that the goroutine is blocked on: the frame closest to the leaf
+// whose function is one of syncWaitFuncs, other than semacquire1. The
+// semacquire1 frame is only used if there is no other such frame, and if the
+// goroutine isn't blocked on a mutex. Goroutines blocked on a mutex are only
+// returned if the mutex is the writers' mutex of a RWMutex.
+func syncWaitFrame(calls []pp.Call) (int, bool) {
+	sema := -1
+	for i := 0; i < len(calls); i++ {
+		fn := calls[i].Func.Complete
+		if _, ok := mutexLockFuncs[fn]; ok {
+			for i < len(calls) {
+				if _, ok := mutexLockFuncs[calls[i].Func.Complete]; !ok {
+					break
+				}
+				i++
+			}
+			if i < len(calls) && calls[i].Func.Complete == "sync.(*RWMutex).Lock" {
+				return i, true
+			}
+			return 0, false
+		}
+		wf, ok := syncWaitFuncs[fn]
+		if !ok {
+			continue
+		}
+		if wf.kind != agentrpc.SyncPrimitive_SEMAPHORE {
+			return i, true
+		}
+		if sema < 0 {
+			sema = i
+		}
+	}
+	return sema, sema >= 0
+}
+
+// decodeSyncState populates p's counters. v is the pointer to the primitive, as
+// read from a waiter's frame.
+func decodeSyncState(p *agentrpc.SyncPrimitive, v *capturedValue) {
+	switch p.Kind {
+	case agentrpc.SyncPrimitive_WAIT_GROUP:
+		// The high 32 bits of the state are the counter, and the low ones are
+		// the number of waiters. Since Go 1.25, the waiters' highest bit is
+		// used as a flag. Before Go 1.20, the state is called state1.
+		state, ok := intField(v, "state")
+		if !ok {
+			if state, ok = intField(v, "state1"); !ok {
+				return
+			}
+		}
+		p.Counter = int64(int32(uint64(state) >> 32))
+		p.RuntimeWaiters = state & 0x7fffffff
+	case agentrpc.SyncPrimitive_COND:
+		// The notify list hands out tickets to the waiters; wait is the next
+		// ticket, and notify is the next ticket to be notified.
+		notify := findStructField(v, "notify")
+		if notify == nil {
+			return
+		}
+		wait, ok1 := intField(notify, "wait")
+		notified, ok2 := intField(notify, "notify")
+		if ok1 && ok2 {
+			p.Counter = int64(uint32(wait) - uint32(notified))
+		}
+	case agentrpc.SyncPrimitive_RW_MUTEX:
+		readers, ok := intField(v, "readerCount")
+		if !ok {
+			return
+		}
+		if readers < 0 {
+			readers += rwmutexMaxReaders
+			p.WriterPending = true
+			p.DepartingReaders, _ = intField(v, "readerWait")
+		}
+		p.Counter = readers
+	case agentrpc.SyncPrimitive_SEMAPHORE:
+		if len(v.Children) > 0 {
+			p.Counter, _ = parseInt(v.Children[0].Value)
+		}
+	}
+}
+
+// intField returns the integer value of the first field with the given name
+// found in a breadth-first search of v's children. The values of the
+// sync/atomic types are unwrapped.
+func intField(v *capturedValue, name string) (int64, bool) {
+	f := findStructField(v, name)
+	if f == nil {
+		return 0, false
+	}
+	if f.Kind == "struct" {
+		// The sync/atomic types store their value in a field called v.
+		if f = findStructField(f, "v"); f == nil {
+			return 0, false
+		}
+	}
+	return parseInt(f.Value)
+}
+
+// parseInt parses the value of a signed or unsigned integer variable. Unsigned
+// values that don't fit in an int64 wrap around.
+func parseInt(s string) (int64, bool) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, true
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	return int64(n), err == nil
+}
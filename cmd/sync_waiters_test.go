@@ -0,0 +1,173 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/andreimatei/delve-agent/agentrpc"
+	pp "github.com/maruel/panicparse/v2/stack"
+)
+
+func TestSyncWaitFrame(t *testing.T) {
+	calls := func(funcs ...string) []pp.Call {
+		res := make([]pp.Call, len(funcs))
+		for i, fn := range funcs {
+			res[i].Func.Complete = fn
+		}
+		return res
+	}
+	for _, tc := range []struct {
+		name  string
+		calls []pp.Call
+		idx   int
+		ok    bool
+	}{
+		{
+			name:  "wait group",
+			calls: calls("runtime.gopark", "runtime.semacquire1", "sync.runtime_Semacquire", "sync.(*WaitGroup).Wait", "main.main"),
+			idx:   3,
+			ok:    true,
+		},
+		{
+			name:  "cond",
+			calls: calls("runtime.gopark", "sync.runtime_notifyListWait", "sync.(*Cond).Wait", "main.main"),
+			idx:   2,
+			ok:    true,
+		},
+		{
+			name:  "rwmutex reader",
+			calls: calls("runtime.gopark", "runtime.semacquire1", "sync.runtime_SemacquireRWMutexR", "sync.(*RWMutex).RLock", "main.main"),
+			idx:   3,
+			ok:    true,
+		},
+		{
+			name:  "rwmutex writer waiting for the readers",
+			calls: calls("runtime.gopark", "runtime.semacquire1", "sync.runtime_SemacquireRWMutex", "sync.(*RWMutex).Lock", "main.main"),
+			idx:   3,
+			ok:    true,
+		},
+		{
+			name:  "rwmutex writer waiting for another writer",
+			calls: calls("runtime.gopark", "runtime.semacquire1", "sync.runtime_SemacquireMutex", "sync.(*Mutex).lockSlow", "sync.(*Mutex).Lock", "sync.(*RWMutex).Lock", "main.main"),
+			idx:   5,
+			ok:    true,
+		},
+		{
+			name:  "mutex",
+			calls: calls("runtime.gopark", "runtime.semacquire1", "sync.runtime_SemacquireMutex", "sync.(*Mutex).lockSlow", "sync.(*Mutex).Lock", "main.main"),
+			ok:    false,
+		},
+		{
+			name:  "internal mutex",
+			calls: calls("runtime.gopark", "runtime.semacquire1", "internal/sync.runtime_SemacquireMutex", "internal/sync.(*Mutex).lockSlow", "internal/sync.(*Mutex).Lock", "sync.(*Mutex).Lock", "main.main"),
+			ok:    false,
+		},
+		{
+			name:  "semaphore",
+			calls: calls("runtime.gopark", "runtime.semacquire1", "main.acquire", "main.main"),
+			idx:   1,
+			ok:    true,
+		},
+		{
+			name:  "not blocked on a primitive",
+			calls: calls("runtime.gopark", "runtime.chanrecv", "main.main"),
+			ok:    false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			idx, ok := syncWaitFrame(tc.calls)
+			if ok != tc.ok || (ok && idx != tc.idx) {
+				t.Fatalf("expected (%d, %t), got (%d, %t)", tc.idx, tc.ok, idx, ok)
+			}
+		})
+	}
+}
+
+// testInt returns a capturedValue for an integer field.
+func testInt(name string, v int64) *capturedValue {
+	return &capturedValue{Name: name, Kind: "int64", Value: strconv.FormatInt(v, 10)}
+}
+
+// testAtomic returns a capturedValue for a sync/atomic field.
+func testAtomic(name string, v int64) *capturedValue {
+	return &capturedValue{Name: name, Kind: "struct", Children: []*capturedValue{testInt("v", v)}}
+}
+
+// testPtr returns a capturedValue for a pointer to a struct with the given
+// fields.
+func testPtr(fields ...*capturedValue) *capturedValue {
+	return &capturedValue{Kind: "ptr", PointerAddr: 0x1000, Children: []*capturedValue{
+		{Kind: "struct", Addr: 0x1000, Children: fields},
+	}}
+}
+
+func TestDecodeSyncState(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		kind agentrpc.SyncPrimitive_Kind
+		v    *capturedValue
+		exp  *agentrpc.SyncPrimitive
+	}{
+		{
+			name: "wait group",
+			kind: agentrpc.SyncPrimitive_WAIT_GROUP,
+			v:    testPtr(testAtomic("state", 3<<32|2)),
+			exp:  &agentrpc.SyncPrimitive{Counter: 3, RuntimeWaiters: 2},
+		},
+		{
+			name: "wait group before go 1.20",
+			kind: agentrpc.SyncPrimitive_WAIT_GROUP,
+			v:    testPtr(testInt("state1", 1<<32|1)),
+			exp:  &agentrpc.SyncPrimitive{Counter: 1, RuntimeWaiters: 1},
+		},
+		{
+			name: "wait group with the synctest flag",
+			kind: agentrpc.SyncPrimitive_WAIT_GROUP,
+			v:    testPtr(testAtomic("state", 1<<32|1<<31|4)),
+			exp:  &agentrpc.SyncPrimitive{Counter: 1, RuntimeWaiters: 4},
+		},
+		{
+			name: "cond",
+			kind: agentrpc.SyncPrimitive_COND,
+			v: testPtr(&capturedValue{Name: "notify", Kind: "struct", Children: []*capturedValue{
+				testAtomic("wait", 7), testInt("notify", 4),
+			}}),
+			exp: &agentrpc.SyncPrimitive{Counter: 3},
+		},
+		{
+			name: "rwmutex readers",
+			kind: agentrpc.SyncPrimitive_RW_MUTEX,
+			v:    testPtr(testAtomic("readerCount", 2), testAtomic("readerWait", 0)),
+			exp:  &agentrpc.SyncPrimitive{Counter: 2},
+		},
+		{
+			name: "rwmutex pending writer",
+			kind: agentrpc.SyncPrimitive_RW_MUTEX,
+			v:    testPtr(testAtomic("readerCount", 2-rwmutexMaxReaders), testAtomic("readerWait", 1)),
+			exp:  &agentrpc.SyncPrimitive{Counter: 2, WriterPending: true, DepartingReaders: 1},
+		},
+		{
+			name: "semaphore",
+			kind: agentrpc.SyncPrimitive_SEMAPHORE,
+			v:    &capturedValue{Kind: "ptr", PointerAddr: 0x1000, Children: []*capturedValue{{Kind: "uint32", Value: "5"}}},
+			exp:  &agentrpc.SyncPrimitive{Counter: 5},
+		},
+		{
+			name: "missing fields",
+			kind: agentrpc.SyncPrimitive_RW_MUTEX,
+			v:    testPtr(testInt("w", 0)),
+			exp:  &agentrpc.SyncPrimitive{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &agentrpc.SyncPrimitive{Kind: tc.kind}
+			decodeSyncState(p, tc.v)
+			if p.Counter != tc.exp.Counter ||
+				p.RuntimeWaiters != tc.exp.RuntimeWaiters ||
+				p.WriterPending != tc.exp.WriterPending ||
+				p.DepartingReaders != tc.exp.DepartingReaders {
+				t.Fatalf("expected %v, got %v", tc.exp, p)
+			}
+		})
+	}
+}
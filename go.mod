@@ -7,6 +7,7 @@ require (
 	github.com/google/pprof v0.0.0-20230808223545-4887780b67fb
 	github.com/kr/pretty v0.2.1
 	github.com/maruel/panicparse/v2 v2.3.1
+	golang.org/x/sys v0.7.0
 	google.golang.org/grpc v1.57.0
 	google.golang.org/protobuf v1.30.0
 )
@@ -24,7 +25,6 @@ require (
 	go.starlark.net v0.0.0-20220816155156-cfacd8902214 // indirect
 	golang.org/x/arch v0.0.0-20190927153633-4e8777c89be4 // indirect
 	golang.org/x/net v0.9.0 // indirect
-	golang.org/x/sys v0.7.0 // indirect
 	golang.org/x/text v0.9.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20230525234030-28d5490b6b19 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect